@@ -21,6 +21,10 @@ var (
 	ModuleCdc           = types.ModuleCdc
 	RegisterCodec       = types.RegisterCodec
 	DefaultGenesisState = types.DefaultGenesisState
+	ValidateGenesis     = types.ValidateGenesis
+	InitGenesis         = keeper.InitGenesis
+	ExportGenesis       = keeper.ExportGenesis
+	NewMsgRouter        = keeper.NewMsgRouter
 )
 
 type (
@@ -39,4 +43,11 @@ type (
 	EthereumBridgeMultiSigUpdateClaim  = types.EthereumBridgeMultiSigUpdateClaim
 	Params                             = types.Params
 	GenesisState                       = types.GenesisState
+	MsgRouter                          = types.MsgRouter
+	QueryRouter                        = types.QueryRouter
+	Querier                            = keeper.Querier
+	MsgRegisterERC20                   = types.MsgRegisterERC20
+	MsgDeployERC20                     = types.MsgDeployERC20
+	MsgERC20DeployedClaim              = types.MsgERC20DeployedClaim
+	ERC20ToDenom                       = types.ERC20ToDenom
 )
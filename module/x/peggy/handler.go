@@ -0,0 +1,119 @@
+package peggy
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/peggy/module/x/peggy/keeper"
+	"github.com/althea-net/peggy/module/x/peggy/types"
+)
+
+// NewHandler returns the legacy amino sdk.Handler that routes each peggy Msg
+// to the matching MsgRouter method. MsgRouter is a plain internal interface
+// (one method per Msg kind) purely for code organization; see its doc
+// comment in types/msg_router.go for why this module has no gRPC Msg
+// service.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	msgRouter := keeper.NewMsgRouter(k)
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		c := sdk.WrapSDKContext(ctx)
+
+		switch msg := msg.(type) {
+		case types.MsgSendToEth:
+			res, err := msgRouter.SendToEth(c, &msg)
+			return wrapResult(ctx, res, err)
+		case types.MsgRequestBatch:
+			res, err := msgRouter.RequestBatch(c, &msg)
+			return wrapResult(ctx, res, err)
+		case types.MsgConfirmBatch:
+			res, err := msgRouter.ConfirmBatch(c, &msg)
+			return wrapResult(ctx, res, err)
+		case types.MsgSetEthAddress:
+			res, err := msgRouter.SetEthAddress(c, &msg)
+			return wrapResult(ctx, res, err)
+		case types.MsgValsetConfirm:
+			res, err := msgRouter.ValsetConfirm(c, &msg)
+			return wrapResult(ctx, res, err)
+		case types.MsgValsetRequest:
+			res, err := msgRouter.ValsetRequest(c, &msg)
+			return wrapResult(ctx, res, err)
+		case types.MsgCreateEthereumClaims:
+			return handleLegacyEthereumClaims(ctx, msgRouter, msg)
+		case types.MsgRegisterERC20:
+			res, err := msgRouter.RegisterERC20(c, &msg)
+			return wrapResult(ctx, res, err)
+		case types.MsgDeployERC20:
+			res, err := msgRouter.DeployERC20(c, &msg)
+			return wrapResult(ctx, res, err)
+		case types.MsgERC20DeployedClaim:
+			res, err := msgRouter.ERC20DeployedClaim(c, &msg)
+			return wrapResult(ctx, res, err)
+		case types.MsgSubmitLogicCall:
+			res, err := msgRouter.SubmitLogicCall(c, &msg)
+			return wrapResult(ctx, res, err)
+		case types.MsgConfirmLogicCall:
+			res, err := msgRouter.ConfirmLogicCall(c, &msg)
+			return wrapResult(ctx, res, err)
+		case types.MsgLogicCallExecutedClaim:
+			res, err := msgRouter.LogicCallExecutedClaim(c, &msg)
+			return wrapResult(ctx, res, err)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized peggy message type: %T", msg)
+		}
+	}
+}
+
+// wrapResult turns a MsgRouter method's (response, error) pair into the
+// legacy sdk.Result/error pair the amino Handler returns, amino-encoding the
+// response into Result.Data the same way the pre-Stargate baseapp expects a
+// handler to report its return value.
+func wrapResult(ctx sdk.Context, res interface{}, err error) (*sdk.Result, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &sdk.Result{
+		Data:   types.ModuleCdc.MustMarshalBinaryLengthPrefixed(res),
+		Events: ctx.EventManager().ABCIEvents(),
+	}, nil
+}
+
+// handleLegacyEthereumClaims fans the old batched claim envelope out into
+// the typed per-claim-kind MsgRouter methods
+func handleLegacyEthereumClaims(ctx sdk.Context, msgRouter types.MsgRouter, msg types.MsgCreateEthereumClaims) (*sdk.Result, error) {
+	c := sdk.WrapSDKContext(ctx)
+	for _, claim := range msg.Claims {
+		var err error
+		switch claim := claim.(type) {
+		case types.EthereumBridgeDepositClaim:
+			_, err = msgRouter.DepositClaim(c, &types.MsgDepositClaim{
+				EventNonce:     claim.EventNonce,
+				TokenContract:  claim.TokenContract,
+				Amount:         claim.Amount,
+				EthereumSender: claim.EthereumSender,
+				CosmosReceiver: claim.CosmosReceiver,
+				Orchestrator:   msg.Orchestrator,
+			})
+		case types.EthereumBridgeWithdrawalBatchClaim:
+			_, err = msgRouter.WithdrawClaim(c, &types.MsgWithdrawClaim{
+				EventNonce:    claim.EventNonce,
+				BatchNonce:    claim.BatchNonce,
+				TokenContract: claim.TokenContract,
+				Orchestrator:  msg.Orchestrator,
+			})
+		case types.EthereumBridgeMultiSigUpdateClaim:
+			_, err = msgRouter.ValsetUpdateClaim(c, &types.MsgValsetUpdateClaim{
+				EventNonce:   claim.EventNonce,
+				ValsetNonce:  claim.ValsetNonce,
+				Orchestrator: msg.Orchestrator,
+			})
+		default:
+			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized claim type: %T", claim)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
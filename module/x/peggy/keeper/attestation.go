@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+)
+
+// SetAttestation persists an Attestation keyed by its event nonce and claim
+// hash, so two orchestrators attesting to different details for the same
+// nonce never collide
+func (k Keeper) SetAttestation(ctx sdk.Context, att types.Attestation) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Set(types.GetAttestationKey(att.EventNonce, att.Claim.ClaimHash()), k.cdc.MustMarshalBinaryBare(att))
+}
+
+// IterateAttestations iterates over every stored Attestation
+func (k Keeper) IterateAttestations(ctx sdk.Context, cb func(att types.Attestation) (stop bool)) {
+	store := ctx.KVStore(k.StoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.OracleAttestationKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var att types.Attestation
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &att)
+		if cb(att) {
+			break
+		}
+	}
+}
+
+// attestationVotingPowerThreshold is the fraction of total bonded voting
+// power that must have voted for a claim before it is Observed and its
+// effects are applied to chain state. Matches the 2/3 supermajority used
+// elsewhere in Cosmos consensus.
+var attestationVotingPowerThreshold = sdk.NewDec(2).Quo(sdk.NewDec(3))
+
+// recordClaim records a single orchestrator's vote for an Ethereum event,
+// creating the Attestation for this (nonce, claim) pair if it is the first
+// vote seen for it, and marks it Observed once its votes cross
+// attestationVotingPowerThreshold of total bonded power. It returns whether
+// this call is the one that newly crossed that threshold, so the caller
+// knows whether to apply the claim's side effects.
+func (k Keeper) recordClaim(ctx sdk.Context, orchestrator sdk.AccAddress, claim types.EthereumClaim) (bool, error) {
+	if err := claim.ValidateBasic(); err != nil {
+		return false, err
+	}
+
+	validator := sdk.ValAddress(orchestrator)
+	hash := claim.ClaimHash()
+
+	store := ctx.KVStore(k.StoreKey)
+	key := types.GetAttestationKey(claim.GetEventNonce(), hash)
+
+	var att types.Attestation
+	if bz := store.Get(key); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &att)
+	} else {
+		att = types.Attestation{EventNonce: claim.GetEventNonce(), Claim: claim}
+	}
+
+	for _, v := range att.Votes {
+		if v.Equals(validator) {
+			return false, sdkerrors.Wrap(types.ErrDuplicate, "orchestrator already submitted this claim")
+		}
+	}
+	att.Votes = append(att.Votes, validator)
+
+	wasObserved := att.Observed
+	att.Observed = wasObserved || k.hasAttestationQuorum(ctx, att.Votes)
+	k.SetAttestation(ctx, att)
+	k.SetLastEventNonceByValidator(ctx, validator, claim.GetEventNonce())
+
+	newlyObserved := att.Observed && !wasObserved
+	if newlyObserved {
+		if nonce := claim.GetEventNonce(); nonce > k.GetLastObservedEventNonce(ctx, claim.GetType()) {
+			k.SetLastObservedEventNonce(ctx, claim.GetType(), nonce)
+		}
+	}
+	return newlyObserved, nil
+}
+
+// hasAttestationQuorum reports whether votes, summed by each voter's current
+// consensus power, meets attestationVotingPowerThreshold of total bonded
+// power. A validator that has since unbonded contributes no power.
+func (k Keeper) hasAttestationQuorum(ctx sdk.Context, votes []sdk.ValAddress) bool {
+	totalPower := k.StakingKeeper.GetLastTotalPower(ctx)
+	if !totalPower.IsPositive() {
+		return false
+	}
+
+	var votedPower int64
+	for _, v := range votes {
+		validator := k.StakingKeeper.Validator(ctx, v)
+		if validator == nil {
+			continue
+		}
+		votedPower += validator.GetConsensusPower()
+	}
+
+	requiredPower := attestationVotingPowerThreshold.MulInt64(totalPower.Int64())
+	return sdk.NewDec(votedPower).GTE(requiredPower)
+}
+
+// lastObservedEventNonceKey namespaces the single last-observed-nonce
+// counter by claim type, since each claim type's Ethereum event stream is
+// independently ordered
+func lastObservedEventNonceKey(claimType types.ClaimType) []byte {
+	return append(types.LastObservedEventNonceKey, []byte(claimType)...)
+}
+
+// SetLastObservedEventNonce records the highest event nonce fully processed
+// for the given claim type
+func (k Keeper) SetLastObservedEventNonce(ctx sdk.Context, claimType types.ClaimType, nonce uint64) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Set(lastObservedEventNonceKey(claimType), sdk.Uint64ToBigEndian(nonce))
+}
+
+// GetLastObservedEventNonce returns the highest event nonce fully processed
+// for the given claim type, or 0 if none has been observed yet
+func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context, claimType types.ClaimType) uint64 {
+	store := ctx.KVStore(k.StoreKey)
+	bz := store.Get(lastObservedEventNonceKey(claimType))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastEventNonceByValidator records the last Ethereum event nonce a
+// validator's orchestrator has submitted a claim for
+func (k Keeper) SetLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress, nonce uint64) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Set(types.GetLastEventNonceByValidatorKey(validator), sdk.Uint64ToBigEndian(nonce))
+}
+
+// GetLastEventNonceByValidator returns the last Ethereum event nonce a
+// validator's orchestrator has submitted a claim for
+func (k Keeper) GetLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.StoreKey)
+	bz := store.Get(types.GetLastEventNonceByValidatorKey(validator))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
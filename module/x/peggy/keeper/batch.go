@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+)
+
+// StoreBatch persists an OutgoingTxBatch by (token contract, nonce)
+func (k Keeper) StoreBatch(ctx sdk.Context, batch types.OutgoingTxBatch) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Set(types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce), k.cdc.MustMarshalBinaryBare(batch))
+}
+
+// GetBatch returns an OutgoingTxBatch, or false if it does not exist
+func (k Keeper) GetBatch(ctx sdk.Context, tokenContract string, nonce uint64) (types.OutgoingTxBatch, bool) {
+	store := ctx.KVStore(k.StoreKey)
+	bz := store.Get(types.GetOutgoingTxBatchKey(tokenContract, nonce))
+	if bz == nil {
+		return types.OutgoingTxBatch{}, false
+	}
+	var batch types.OutgoingTxBatch
+	k.cdc.MustUnmarshalBinaryBare(bz, &batch)
+	return batch, true
+}
+
+// DeleteBatch removes a batch once it has been observed as executed on
+// Ethereum
+func (k Keeper) DeleteBatch(ctx sdk.Context, batch types.OutgoingTxBatch) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Delete(types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce))
+}
+
+// IterateOutgoingTxBatches iterates over every outstanding OutgoingTxBatch
+func (k Keeper) IterateOutgoingTxBatches(ctx sdk.Context, cb func(batch types.OutgoingTxBatch) (stop bool)) {
+	store := ctx.KVStore(k.StoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.OutgoingTXBatchKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var batch types.OutgoingTxBatch
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &batch)
+		if cb(batch) {
+			break
+		}
+	}
+}
+
+// SetBatchConfirm persists a validator's Ethereum signature over an
+// OutgoingTxBatch
+func (k Keeper) SetBatchConfirm(ctx sdk.Context, confirm types.BatchConfirm) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Set(types.GetBatchConfirmKey(confirm.TokenContract, confirm.Nonce, confirm.Orchestrator), k.cdc.MustMarshalBinaryBare(confirm))
+}
+
+// IterateBatchConfirms iterates over every stored batch confirmation
+func (k Keeper) IterateBatchConfirms(ctx sdk.Context, cb func(confirm types.BatchConfirm) (stop bool)) {
+	store := ctx.KVStore(k.StoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.OutgoingTXBatchConfirmKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var confirm types.BatchConfirm
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &confirm)
+		if cb(confirm) {
+			break
+		}
+	}
+}
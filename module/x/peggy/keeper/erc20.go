@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+)
+
+// RegisterERC20 records a bijective denom<->ERC20 address mapping. It
+// rejects the call if either side is already registered to a different
+// counterpart, since MsgSendToEth and the deposit-claim handler both rely
+// on this table being unambiguous.
+func (k Keeper) RegisterERC20(ctx sdk.Context, denom, erc20Address string) error {
+	erc20Address = types.NormalizeEthAddress(erc20Address)
+	if existing, found := k.GetERC20ForDenom(ctx, denom); found && existing != erc20Address {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "denom %s already registered to %s", denom, existing)
+	}
+	if existingDenom, found := k.GetDenomForERC20(ctx, erc20Address); found && existingDenom != denom {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "erc20 %s already registered to %s", erc20Address, existingDenom)
+	}
+
+	store := ctx.KVStore(k.StoreKey)
+	store.Set(types.GetDenomToERC20Key(denom), []byte(erc20Address))
+	store.Set(types.GetERC20ToDenomKey(erc20Address), []byte(denom))
+	return nil
+}
+
+// GetERC20ForDenom returns the ERC20 contract address registered for a
+// denom, or false if none has been registered
+func (k Keeper) GetERC20ForDenom(ctx sdk.Context, denom string) (string, bool) {
+	store := ctx.KVStore(k.StoreKey)
+	bz := store.Get(types.GetDenomToERC20Key(denom))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// GetDenomForERC20 returns the denom registered for an ERC20 contract
+// address, or false if none has been registered
+func (k Keeper) GetDenomForERC20(ctx sdk.Context, erc20Address string) (string, bool) {
+	store := ctx.KVStore(k.StoreKey)
+	bz := store.Get(types.GetERC20ToDenomKey(erc20Address))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// IterateERC20ToDenom iterates over every registered ERC20<->denom pair
+func (k Keeper) IterateERC20ToDenom(ctx sdk.Context, cb func(entry types.ERC20ToDenom) (stop bool)) {
+	store := ctx.KVStore(k.StoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DenomToERC20Key)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.DenomToERC20Key):])
+		if cb(types.ERC20ToDenom{Denom: denom, ERC20Address: string(iter.Value())}) {
+			break
+		}
+	}
+}
+
+// SetERC20Metadata records the bank.Metadata for a newly registered or
+// deployed ERC20-backed denom, so vouchers minted on deposit display with
+// the same name/symbol/decimals as the original ERC20 token
+func (k Keeper) SetERC20Metadata(ctx sdk.Context, denom, name, symbol string, decimals uint32) {
+	k.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:    denom,
+		Display: symbol,
+		Name:    name,
+		Symbol:  symbol,
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: symbol, Exponent: decimals},
+		},
+	})
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterERC20RejectsReassignment(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	require.NoError(t, k.RegisterERC20(ctx, "uatom", "0x1111111111111111111111111111111111111111"))
+
+	erc20, found := k.GetERC20ForDenom(ctx, "uatom")
+	require.True(t, found)
+	require.Equal(t, "0x1111111111111111111111111111111111111111", erc20)
+
+	denom, found := k.GetDenomForERC20(ctx, "0x1111111111111111111111111111111111111111")
+	require.True(t, found)
+	require.Equal(t, "uatom", denom)
+
+	// re-registering the same pair is idempotent
+	require.NoError(t, k.RegisterERC20(ctx, "uatom", "0x1111111111111111111111111111111111111111"))
+
+	// binding the same denom to a different ERC20 is rejected
+	require.Error(t, k.RegisterERC20(ctx, "uatom", "0x2222222222222222222222222222222222222222"))
+
+	// binding a different denom to the same ERC20 is rejected
+	require.Error(t, k.RegisterERC20(ctx, "uother", "0x1111111111111111111111111111111111111111"))
+}
+
+func TestRegisterERC20NormalizesCase(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	require.NoError(t, k.RegisterERC20(ctx, "uatom", "0xAbAbAbAbAbAbAbAbAbAbAbAbAbAbAbAbAbAbAbAb"))
+
+	// re-registering with different letter casing is the same pair, not a
+	// rebinding, and the stored forward mapping is normalized regardless of
+	// how the caller cased it
+	require.NoError(t, k.RegisterERC20(ctx, "uatom", "0xABABABABABABABABABABABABABABABABABABABAB"))
+
+	erc20, found := k.GetERC20ForDenom(ctx, "uatom")
+	require.True(t, found)
+	require.Equal(t, "0xabababababababababababababababababababab", erc20)
+
+	denom, found := k.GetDenomForERC20(ctx, "0xABABABABABABABABABABABABABABABABABABABAB")
+	require.True(t, found)
+	require.Equal(t, "uatom", denom)
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+)
+
+// allClaimTypes lists every ClaimType whose last-observed-nonce counter is
+// part of genesis, in the stable order genesis JSON is written in
+var allClaimTypes = []types.ClaimType{
+	types.ClaimTypeEthereumBridgeDeposit,
+	types.ClaimTypeEthereumBridgeWithdrawalBatch,
+	types.ClaimTypeEthereumBridgeMultiSigUpdate,
+	types.ClaimTypeLogicCallExecuted,
+	types.ClaimTypeERC20Deployed,
+}
+
+// InitGenesis rehydrates the bridge state held in a GenesisState: eth
+// address bindings, historical valsets and their confirmations, outstanding
+// batches and their confirmations, logic calls and their confirmations, the
+// next valset and batch nonces, attestation vote tallies, and the last
+// observed nonce per claim type, so relayers need not re-sign the valsets,
+// batches and logic calls in flight. The ERC20<->denom registry, the
+// unbatched outgoing pool and each validator's last claimed event nonce are
+// not part of GenesisState and are not restored.
+func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+
+	for _, binding := range data.EthAddresses {
+		k.SetEthAddress(ctx, binding.Validator, binding.EthAddress)
+	}
+
+	for _, valset := range data.Valsets {
+		k.StoreValset(ctx, valset)
+	}
+
+	for _, confirm := range data.ValsetConfirms {
+		k.SetValsetConfirm(ctx, confirm)
+	}
+
+	k.setNextValsetNonce(ctx, data.NextValsetNonce)
+
+	for _, batch := range data.Batches {
+		k.StoreBatch(ctx, batch)
+	}
+
+	for _, confirm := range data.BatchConfirms {
+		k.SetBatchConfirm(ctx, confirm)
+	}
+
+	k.setNextBatchNonce(ctx, data.NextBatchNonce)
+
+	for _, call := range data.LogicCalls {
+		if err := k.SetLogicCall(ctx, call); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, confirm := range data.LogicCallConfirms {
+		k.SetLogicCallConfirm(ctx, confirm)
+	}
+
+	for _, att := range data.Attestations {
+		k.SetAttestation(ctx, att)
+	}
+
+	for _, last := range data.LastObservedEventNonces {
+		k.SetLastObservedEventNonce(ctx, last.ClaimType, last.Nonce)
+	}
+}
+
+// ExportGenesis walks every bridge store and packs it into a GenesisState,
+// the inverse of InitGenesis
+func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
+	var ethAddresses []types.EthAddressBinding
+	k.IterateEthAddresses(ctx, func(validator sdk.ValAddress, ethAddress string) bool {
+		ethAddresses = append(ethAddresses, types.EthAddressBinding{
+			Validator:  validator,
+			EthAddress: ethAddress,
+		})
+		return false
+	})
+
+	var valsets []types.Valset
+	k.IterateValsets(ctx, func(valset types.Valset) bool {
+		valsets = append(valsets, valset)
+		return false
+	})
+
+	var valsetConfirms []types.ValsetConfirm
+	k.IterateValsetConfirms(ctx, func(confirm types.ValsetConfirm) bool {
+		valsetConfirms = append(valsetConfirms, confirm)
+		return false
+	})
+
+	var batches []types.OutgoingTxBatch
+	k.IterateOutgoingTxBatches(ctx, func(batch types.OutgoingTxBatch) bool {
+		batches = append(batches, batch)
+		return false
+	})
+
+	var batchConfirms []types.BatchConfirm
+	k.IterateBatchConfirms(ctx, func(confirm types.BatchConfirm) bool {
+		batchConfirms = append(batchConfirms, confirm)
+		return false
+	})
+
+	var logicCalls []types.OutgoingLogicCall
+	k.IterateLogicCalls(ctx, func(call types.OutgoingLogicCall) bool {
+		logicCalls = append(logicCalls, call)
+		return false
+	})
+
+	var logicCallConfirms []types.LogicCallConfirm
+	k.IterateLogicCallConfirms(ctx, func(confirm types.LogicCallConfirm) bool {
+		logicCallConfirms = append(logicCallConfirms, confirm)
+		return false
+	})
+
+	var attestations []types.Attestation
+	k.IterateAttestations(ctx, func(att types.Attestation) bool {
+		attestations = append(attestations, att)
+		return false
+	})
+
+	lastObserved := make([]types.LastObservedEventNonce, 0, len(allClaimTypes))
+	for _, claimType := range allClaimTypes {
+		lastObserved = append(lastObserved, types.LastObservedEventNonce{
+			ClaimType: claimType,
+			Nonce:     k.GetLastObservedEventNonce(ctx, claimType),
+		})
+	}
+
+	return types.GenesisState{
+		Params:                  k.GetParams(ctx),
+		EthAddresses:            ethAddresses,
+		Valsets:                 valsets,
+		ValsetConfirms:          valsetConfirms,
+		NextValsetNonce:         k.GetNextValsetNonce(ctx),
+		Batches:                 batches,
+		BatchConfirms:           batchConfirms,
+		NextBatchNonce:          k.GetNextBatchNonce(ctx),
+		LogicCalls:              logicCalls,
+		LogicCallConfirms:       logicCallConfirms,
+		Attestations:            attestations,
+		LastObservedEventNonces: lastObserved,
+	}
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+)
+
+// OutgoingTxBatchSize is the maximum number of OutgoingTransferTxs packed
+// into a single OutgoingTxBatch. It bounds the gas cost of the Ethereum
+// side batch submission transaction.
+const OutgoingTxBatchSize = 100
+
+// Keeper maintains the link to data storage and exposes getter/setter
+// methods for the various parts of the bridge state. It depends only on
+// the expected-keeper interfaces in types/expected_keepers.go rather than
+// concrete bank/staking/supply keepers, so batch construction, valset
+// diffing, and claim attestation can be unit tested against gomock
+// implementations of those interfaces.
+type Keeper struct {
+	StoreKey       sdk.StoreKey
+	cdc            *codec.Codec
+	paramSpace     paramtypes.Subspace
+	BankKeeper     types.BankKeeper
+	StakingKeeper  types.StakingKeeper
+	SupplyKeeper   types.SupplyKeeper
+	SlashingKeeper types.SlashingKeeper
+	// authority is the only address MsgRegisterERC20 will accept as
+	// msg.Authority, normally the gov module account
+	authority sdk.AccAddress
+}
+
+// NewKeeper returns a new instance of the peggy keeper. authority is the
+// address gated governance-controlled messages (MsgRegisterERC20) must be
+// signed by, normally the gov module account's address.
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, stakingKeeper types.StakingKeeper, bankKeeper types.BankKeeper, supplyKeeper types.SupplyKeeper, slashingKeeper types.SlashingKeeper, authority sdk.AccAddress) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		StoreKey:       storeKey,
+		cdc:            cdc,
+		paramSpace:     paramSpace,
+		StakingKeeper:  stakingKeeper,
+		BankKeeper:     bankKeeper,
+		SupplyKeeper:   supplyKeeper,
+		SlashingKeeper: slashingKeeper,
+		authority:      authority,
+	}
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the current peggy module parameters
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the peggy module parameters
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// SetEthAddress binds a validator's orchestrator to an Ethereum address
+func (k Keeper) SetEthAddress(ctx sdk.Context, validator sdk.ValAddress, address string) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Set(types.GetEthAddressKey(validator), []byte(address))
+}
+
+// GetEthAddress returns the Ethereum address bound to a validator, or ""
+// if none has been set
+func (k Keeper) GetEthAddress(ctx sdk.Context, validator sdk.ValAddress) string {
+	store := ctx.KVStore(k.StoreKey)
+	bz := store.Get(types.GetEthAddressKey(validator))
+	if bz == nil {
+		return ""
+	}
+	return string(bz)
+}
+
+// IterateEthAddresses iterates over every validator->Ethereum address
+// binding in the store
+func (k Keeper) IterateEthAddresses(ctx sdk.Context, cb func(validator sdk.ValAddress, ethAddress string) (stop bool)) {
+	store := ctx.KVStore(k.StoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.EthAddressKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		validator := sdk.ValAddress(iter.Key()[len(types.EthAddressKey):])
+		if cb(validator, string(iter.Value())) {
+			break
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+)
+
+// latestLogicCallNonceKey tracks the highest InvalidationNonce stored for a
+// given InvalidationID, so a newer call superseding an older one can find
+// and remove it
+func latestLogicCallNonceKey(invalidationID []byte) []byte {
+	return append(append([]byte{}, types.LogicCallLatestNonceKey...), invalidationID...)
+}
+
+// SetLogicCall persists an OutgoingLogicCall, enforcing that a call only
+// ever supersedes an earlier one sharing its InvalidationID: submitting an
+// equal or lower InvalidationNonce for an ID already on record is rejected,
+// and superseding removes the stale entry so only the latest call for an ID
+// is ever outstanding.
+func (k Keeper) SetLogicCall(ctx sdk.Context, call types.OutgoingLogicCall) error {
+	store := ctx.KVStore(k.StoreKey)
+	nonceKey := latestLogicCallNonceKey(call.InvalidationID)
+
+	if bz := store.Get(nonceKey); bz != nil {
+		latestNonce := sdk.BigEndianToUint64(bz)
+		if call.InvalidationNonce <= latestNonce {
+			return sdkerrors.Wrapf(types.ErrInvalid, "invalidation nonce %d does not supersede outstanding nonce %d", call.InvalidationNonce, latestNonce)
+		}
+		store.Delete(types.GetLogicCallKey(call.InvalidationID, latestNonce))
+	}
+
+	store.Set(nonceKey, sdk.Uint64ToBigEndian(call.InvalidationNonce))
+	store.Set(types.GetLogicCallKey(call.InvalidationID, call.InvalidationNonce), k.cdc.MustMarshalBinaryBare(call))
+	return nil
+}
+
+// GetLatestLogicCall returns the outstanding OutgoingLogicCall for an
+// InvalidationID, if any, using the latest-nonce pointer SetLogicCall
+// maintains
+func (k Keeper) GetLatestLogicCall(ctx sdk.Context, invalidationID []byte) (types.OutgoingLogicCall, bool) {
+	store := ctx.KVStore(k.StoreKey)
+	bz := store.Get(latestLogicCallNonceKey(invalidationID))
+	if bz == nil {
+		return types.OutgoingLogicCall{}, false
+	}
+	return k.GetLogicCall(ctx, invalidationID, sdk.BigEndianToUint64(bz))
+}
+
+// GetLogicCall returns an OutgoingLogicCall by (invalidation id,
+// invalidation nonce), or false if it does not exist
+func (k Keeper) GetLogicCall(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) (types.OutgoingLogicCall, bool) {
+	store := ctx.KVStore(k.StoreKey)
+	bz := store.Get(types.GetLogicCallKey(invalidationID, invalidationNonce))
+	if bz == nil {
+		return types.OutgoingLogicCall{}, false
+	}
+	var call types.OutgoingLogicCall
+	k.cdc.MustUnmarshalBinaryBare(bz, &call)
+	return call, true
+}
+
+// DeleteLogicCall removes a logic call once it has been observed as
+// executed on Ethereum
+func (k Keeper) DeleteLogicCall(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Delete(types.GetLogicCallKey(invalidationID, invalidationNonce))
+}
+
+// IterateLogicCalls iterates over every outstanding OutgoingLogicCall
+func (k Keeper) IterateLogicCalls(ctx sdk.Context, cb func(call types.OutgoingLogicCall) (stop bool)) {
+	store := ctx.KVStore(k.StoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.LogicCallKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var call types.OutgoingLogicCall
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &call)
+		if cb(call) {
+			break
+		}
+	}
+}
+
+// SetLogicCallConfirm persists a validator's Ethereum signature over an
+// OutgoingLogicCall's checkpoint
+func (k Keeper) SetLogicCallConfirm(ctx sdk.Context, confirm types.LogicCallConfirm) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Set(types.GetLogicCallConfirmKey(confirm.InvalidationID, confirm.InvalidationNonce, confirm.Orchestrator), k.cdc.MustMarshalBinaryBare(confirm))
+}
+
+// IterateLogicCallConfirms iterates over every stored logic call
+// confirmation
+func (k Keeper) IterateLogicCallConfirms(ctx sdk.Context, cb func(confirm types.LogicCallConfirm) (stop bool)) {
+	store := ctx.KVStore(k.StoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.LogicCallConfirmKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var confirm types.LogicCallConfirm
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &confirm)
+		if cb(confirm) {
+			break
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+)
+
+func TestSetLogicCallSupersedesOlderNonce(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	invalidationID := []byte("invalidation-id-1")
+
+	require.NoError(t, k.SetLogicCall(ctx, types.OutgoingLogicCall{
+		LogicContractAddress: "0x1111111111111111111111111111111111111111",
+		InvalidationID:       invalidationID,
+		InvalidationNonce:    1,
+	}))
+	_, found := k.GetLogicCall(ctx, invalidationID, 1)
+	require.True(t, found)
+
+	// a higher invalidation nonce for the same ID supersedes the old call
+	require.NoError(t, k.SetLogicCall(ctx, types.OutgoingLogicCall{
+		LogicContractAddress: "0x1111111111111111111111111111111111111111",
+		InvalidationID:       invalidationID,
+		InvalidationNonce:    2,
+	}))
+	_, found = k.GetLogicCall(ctx, invalidationID, 1)
+	require.False(t, found)
+	_, found = k.GetLogicCall(ctx, invalidationID, 2)
+	require.True(t, found)
+
+	// an equal or lower nonce for an ID already on record is rejected
+	require.Error(t, k.SetLogicCall(ctx, types.OutgoingLogicCall{
+		InvalidationID:    invalidationID,
+		InvalidationNonce: 2,
+	}))
+	require.Error(t, k.SetLogicCall(ctx, types.OutgoingLogicCall{
+		InvalidationID:    invalidationID,
+		InvalidationNonce: 1,
+	}))
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+)
+
+// stubStakingKeeper is a no-op types.StakingKeeper for tests that don't
+// exercise valset construction
+type stubStakingKeeper struct{}
+
+func (stubStakingKeeper) GetLastTotalPower(ctx sdk.Context) sdk.Int { return sdk.ZeroInt() }
+func (stubStakingKeeper) Validator(ctx sdk.Context, addr sdk.ValAddress) stakingexported.ValidatorI {
+	return nil
+}
+func (stubStakingKeeper) IterateBondedValidatorsByPower(ctx sdk.Context, fn func(index int64, validator stakingexported.ValidatorI) (stop bool)) {
+}
+
+// stubBankKeeper is a no-op types.BankKeeper for tests that don't exercise
+// coin movement
+type stubBankKeeper struct{}
+
+func (stubBankKeeper) SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata) {}
+
+// stubSupplyKeeper is a no-op types.SupplyKeeper for tests that don't
+// exercise minting, burning, or pool transfers
+type stubSupplyKeeper struct{}
+
+func (stubSupplyKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return nil
+}
+func (stubSupplyKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return nil
+}
+func (stubSupplyKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	return nil
+}
+func (stubSupplyKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	return nil
+}
+
+// stubSlashingKeeper is a no-op types.SlashingKeeper for tests that don't
+// exercise the slashing EndBlocker
+type stubSlashingKeeper struct{}
+
+func (stubSlashingKeeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {}
+func (stubSlashingKeeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64) {
+}
+
+var (
+	_ types.StakingKeeper  = stubStakingKeeper{}
+	_ types.BankKeeper     = stubBankKeeper{}
+	_ types.SupplyKeeper   = stubSupplyKeeper{}
+	_ types.SlashingKeeper = stubSlashingKeeper{}
+)
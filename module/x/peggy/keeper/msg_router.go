@@ -0,0 +1,244 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+)
+
+type msgRouter struct {
+	Keeper
+}
+
+// NewMsgRouter returns an implementation of types.MsgRouter backed by
+// the given Keeper
+func NewMsgRouter(k Keeper) types.MsgRouter {
+	return &msgRouter{Keeper: k}
+}
+
+var _ types.MsgRouter = &msgRouter{}
+
+func (k msgRouter) SendToEth(c context.Context, msg *types.MsgSendToEth) (*types.MsgSendToEthResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, err := k.AddToOutgoingPool(ctx, msg.Sender, msg.EthDest, msg.Amount, msg.BridgeFee); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSendToEth,
+		sdk.NewAttribute(types.AttributeKeySender, msg.Sender.String()),
+		sdk.NewAttribute(types.AttributeKeyEthDest, msg.EthDest),
+	))
+	return &types.MsgSendToEthResponse{}, nil
+}
+
+func (k msgRouter) RequestBatch(c context.Context, msg *types.MsgRequestBatch) (*types.MsgRequestBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	tokenContract, registered := k.GetERC20ForDenom(ctx, msg.Denom)
+	if !registered {
+		return nil, sdkerrors.Wrapf(types.ErrUnknown, "denom %s has no registered ERC20", msg.Denom)
+	}
+	timeout := uint64(ctx.BlockHeight()) + types.DefaultBatchTimeoutBlocks
+	batch, err := k.BuildOutgoingTxBatch(ctx, tokenContract, msg.Denom, timeout)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOutgoingBatch,
+		sdk.NewAttribute(types.AttributeKeyBatchNonce, sdk.NewUint(batch.BatchNonce).String()),
+	))
+	return &types.MsgRequestBatchResponse{}, nil
+}
+
+func (k msgRouter) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (*types.MsgConfirmBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetBatch(ctx, msg.TokenContract, msg.Nonce); !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknown, "batch %s/%d", msg.TokenContract, msg.Nonce)
+	}
+	k.SetBatchConfirm(ctx, types.BatchConfirm{
+		Nonce:         msg.Nonce,
+		TokenContract: msg.TokenContract,
+		Orchestrator:  msg.Orchestrator,
+		EthSigner:     msg.EthSigner,
+		Signature:     msg.Signature,
+	})
+	return &types.MsgConfirmBatchResponse{}, nil
+}
+
+func (k msgRouter) SetEthAddress(c context.Context, msg *types.MsgSetEthAddress) (*types.MsgSetEthAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k.Keeper.SetEthAddress(ctx, msg.Validator, msg.Address)
+	return &types.MsgSetEthAddressResponse{}, nil
+}
+
+func (k msgRouter) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm) (*types.MsgValsetConfirmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetValset(ctx, msg.Nonce); !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknown, "valset %d", msg.Nonce)
+	}
+	k.SetValsetConfirm(ctx, types.ValsetConfirm{
+		Nonce:        msg.Nonce,
+		Orchestrator: msg.Orchestrator,
+		EthAddress:   msg.EthAddress,
+		Signature:    msg.Signature,
+	})
+	return &types.MsgValsetConfirmResponse{}, nil
+}
+
+func (k msgRouter) ValsetRequest(c context.Context, msg *types.MsgValsetRequest) (*types.MsgValsetRequestResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	valset := k.CurrentValset(ctx)
+	k.StoreValset(ctx, valset)
+	return &types.MsgValsetRequestResponse{Nonce: valset.Nonce}, nil
+}
+
+func (k msgRouter) DepositClaim(c context.Context, msg *types.MsgDepositClaim) (*types.MsgDepositClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	denom, registered := k.GetDenomForERC20(ctx, msg.TokenContract)
+	if !registered {
+		return nil, sdkerrors.Wrapf(types.ErrUnknown, "erc20 %s has no registered denom", msg.TokenContract)
+	}
+	observed, err := k.recordClaim(ctx, msg.Orchestrator, *msg)
+	if err != nil {
+		return nil, err
+	}
+	if observed {
+		coins := sdk.NewCoins(sdk.NewCoin(denom, msg.Amount))
+		if err := k.SupplyKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return nil, sdkerrors.Wrap(err, "mint deposited coins")
+		}
+		if err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, msg.CosmosReceiver, coins); err != nil {
+			return nil, sdkerrors.Wrap(err, "credit deposited coins")
+		}
+	}
+	return &types.MsgDepositClaimResponse{}, nil
+}
+
+func (k msgRouter) WithdrawClaim(c context.Context, msg *types.MsgWithdrawClaim) (*types.MsgWithdrawClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	observed, err := k.recordClaim(ctx, msg.Orchestrator, *msg)
+	if err != nil {
+		return nil, err
+	}
+	if observed {
+		if batch, found := k.GetBatch(ctx, msg.TokenContract, msg.BatchNonce); found {
+			k.DeleteBatch(ctx, batch)
+		}
+	}
+	return &types.MsgWithdrawClaimResponse{}, nil
+}
+
+func (k msgRouter) ValsetUpdateClaim(c context.Context, msg *types.MsgValsetUpdateClaim) (*types.MsgValsetUpdateClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, err := k.recordClaim(ctx, msg.Orchestrator, *msg); err != nil {
+		return nil, err
+	}
+	return &types.MsgValsetUpdateClaimResponse{}, nil
+}
+
+func (k msgRouter) RegisterERC20(c context.Context, msg *types.MsgRegisterERC20) (*types.MsgRegisterERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if !msg.Authority.Equals(k.authority) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the gov module account", msg.Authority)
+	}
+	if err := k.Keeper.RegisterERC20(ctx, msg.Denom, msg.ERC20Address); err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterERC20Response{}, nil
+}
+
+func (k msgRouter) DeployERC20(c context.Context, msg *types.MsgDeployERC20) (*types.MsgDeployERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, registered := k.GetERC20ForDenom(ctx, msg.Denom); registered {
+		return nil, sdkerrors.Wrapf(types.ErrDuplicate, "denom %s already has a registered ERC20", msg.Denom)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDeployERC20,
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+		sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+	))
+	return &types.MsgDeployERC20Response{}, nil
+}
+
+func (k msgRouter) ERC20DeployedClaim(c context.Context, msg *types.MsgERC20DeployedClaim) (*types.MsgERC20DeployedClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	observed, err := k.recordClaim(ctx, msg.Orchestrator, *msg)
+	if err != nil {
+		return nil, err
+	}
+	if !observed {
+		return &types.MsgERC20DeployedClaimResponse{}, nil
+	}
+	if err := k.Keeper.RegisterERC20(ctx, msg.Denom, msg.ERC20Address); err != nil {
+		return nil, err
+	}
+	k.SetERC20Metadata(ctx, msg.Denom, msg.Name, msg.Symbol, msg.Decimals)
+	return &types.MsgERC20DeployedClaimResponse{}, nil
+}
+
+func (k msgRouter) SubmitLogicCall(c context.Context, msg *types.MsgSubmitLogicCall) (*types.MsgSubmitLogicCallResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	superseded, hadSuperseded := k.GetLatestLogicCall(ctx, msg.InvalidationID)
+
+	call := types.OutgoingLogicCall{
+		Requester:            msg.Requester,
+		Transfers:            msg.Transfers,
+		Fees:                 msg.Fees,
+		LogicContractAddress: msg.LogicContractAddress,
+		Payload:              msg.Payload,
+		Timeout:              msg.Timeout,
+		InvalidationID:       msg.InvalidationID,
+		InvalidationNonce:    msg.InvalidationNonce,
+		Block:                uint64(ctx.BlockHeight()),
+		Members:              k.bondedBridgeValidators(ctx),
+	}
+	if err := k.SetLogicCall(ctx, call); err != nil {
+		return nil, err
+	}
+
+	if err := k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, msg.Requester, types.ModuleName, sdk.Coins(msg.Transfers).Add(msg.Fees...)); err != nil {
+		return nil, sdkerrors.Wrap(err, "transfer to module account")
+	}
+	if hadSuperseded {
+		if err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, superseded.Requester, sdk.Coins(superseded.Transfers).Add(superseded.Fees...)); err != nil {
+			return nil, sdkerrors.Wrap(err, "refund superseded logic call")
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeLogicCall,
+		sdk.NewAttribute(types.AttributeKeyInvalidationID, string(msg.InvalidationID)),
+	))
+	return &types.MsgSubmitLogicCallResponse{}, nil
+}
+
+func (k msgRouter) ConfirmLogicCall(c context.Context, msg *types.MsgConfirmLogicCall) (*types.MsgConfirmLogicCallResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetLogicCall(ctx, msg.InvalidationID, msg.InvalidationNonce); !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknown, "logic call %x/%d", msg.InvalidationID, msg.InvalidationNonce)
+	}
+	k.SetLogicCallConfirm(ctx, types.LogicCallConfirm{
+		InvalidationID:    msg.InvalidationID,
+		InvalidationNonce: msg.InvalidationNonce,
+		Orchestrator:      msg.Orchestrator,
+		EthSigner:         msg.EthSigner,
+		Signature:         msg.Signature,
+	})
+	return &types.MsgConfirmLogicCallResponse{}, nil
+}
+
+func (k msgRouter) LogicCallExecutedClaim(c context.Context, msg *types.MsgLogicCallExecutedClaim) (*types.MsgLogicCallExecutedClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	observed, err := k.recordClaim(ctx, msg.Orchestrator, *msg)
+	if err != nil {
+		return nil, err
+	}
+	if observed {
+		k.DeleteLogicCall(ctx, msg.InvalidationID, msg.InvalidationNonce)
+	}
+	return &types.MsgLogicCallExecutedClaimResponse{}, nil
+}
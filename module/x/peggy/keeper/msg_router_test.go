@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/althea-net/peggy/module/x/peggy/types/mocks"
+)
+
+// TestRegisterERC20RequiresGovAuthority asserts that MsgRegisterERC20 is
+// rejected unless its Authority matches the Keeper's configured gov module
+// account, since anyone able to submit it otherwise could rebind a denom's
+// ERC20 contract at will
+func TestRegisterERC20RequiresGovAuthority(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+	router := NewMsgRouter(k)
+	c := sdk.WrapSDKContext(ctx)
+
+	_, err := router.RegisterERC20(c, &types.MsgRegisterERC20{
+		Authority:    sdk.AccAddress([]byte("not-the-gov-account-")),
+		Denom:        "uatom",
+		ERC20Address: "0x1111111111111111111111111111111111111111",
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, found := k.GetERC20ForDenom(ctx, "uatom")
+	require.False(t, found)
+
+	_, err = router.RegisterERC20(c, &types.MsgRegisterERC20{
+		Authority:    k.authority,
+		Denom:        "uatom",
+		ERC20Address: "0x1111111111111111111111111111111111111111",
+	})
+	require.NoError(t, err)
+	erc20, found := k.GetERC20ForDenom(ctx, "uatom")
+	require.True(t, found)
+	require.Equal(t, "0x1111111111111111111111111111111111111111", erc20)
+}
+
+// TestDepositClaimCreditsReceiverOnQuorum asserts that a MsgDepositClaim
+// mints and credits the CosmosReceiver once recordClaim reports attestation
+// quorum was newly reached. Here the single voter holds all bonded power,
+// so its one vote reaches quorum.
+func TestDepositClaimCreditsReceiverOnQuorum(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+	require.NoError(t, k.RegisterERC20(ctx, "uatom", "0x1111111111111111111111111111111111111111"))
+
+	validator := sdk.ValAddress([]byte("validator1----------"))
+	receiver := sdk.AccAddress([]byte("receiver------------"))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	stakingKeeper := mocks.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(sdk.NewInt(100)).AnyTimes()
+	stakingKeeper.EXPECT().Validator(gomock.Any(), validator).Return(fakeValidator{operator: validator, power: 100}).AnyTimes()
+	k.StakingKeeper = stakingKeeper
+
+	supplyKeeper := mocks.NewMockSupplyKeeper(ctrl)
+	supplyKeeper.EXPECT().MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uatom", 50)))
+	supplyKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(sdk.NewInt64Coin("uatom", 50)))
+	k.SupplyKeeper = supplyKeeper
+
+	router := NewMsgRouter(k)
+	c := sdk.WrapSDKContext(ctx)
+	_, err := router.DepositClaim(c, &types.MsgDepositClaim{
+		EventNonce:     1,
+		TokenContract:  "0x1111111111111111111111111111111111111111",
+		Amount:         sdk.NewInt(50),
+		EthereumSender: "0x2222222222222222222222222222222222222222",
+		CosmosReceiver: receiver,
+		Orchestrator:   sdk.AccAddress(validator),
+	})
+	require.NoError(t, err)
+}
+
+// TestWithdrawClaimDeletesBatchOnQuorum asserts that a MsgWithdrawClaim only
+// removes the outstanding batch once recordClaim reports attestation quorum
+// was newly reached
+func TestWithdrawClaimDeletesBatchOnQuorum(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+	k.StoreBatch(ctx, types.OutgoingTxBatch{
+		BatchNonce:    1,
+		TokenContract: "0x1111111111111111111111111111111111111111",
+	})
+
+	validator := sdk.ValAddress([]byte("validator1----------"))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	stakingKeeper := mocks.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(sdk.NewInt(100)).AnyTimes()
+	stakingKeeper.EXPECT().Validator(gomock.Any(), validator).Return(fakeValidator{operator: validator, power: 100}).AnyTimes()
+	k.StakingKeeper = stakingKeeper
+
+	router := NewMsgRouter(k)
+	c := sdk.WrapSDKContext(ctx)
+	_, err := router.WithdrawClaim(c, &types.MsgWithdrawClaim{
+		EventNonce:    1,
+		BatchNonce:    1,
+		TokenContract: "0x1111111111111111111111111111111111111111",
+		Orchestrator:  sdk.AccAddress(validator),
+	})
+	require.NoError(t, err)
+
+	_, found := k.GetBatch(ctx, "0x1111111111111111111111111111111111111111", 1)
+	require.False(t, found)
+}
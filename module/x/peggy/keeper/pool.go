@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+)
+
+// nextPoolIDKey tracks the next unbatched OutgoingTransferTx ID to assign,
+// namespaced under the pool's own key prefix
+var nextPoolIDKey = append(types.OutgoingTXPoolKey, 0xFF)
+
+func getPoolTxKey(id uint64) []byte {
+	return append(types.OutgoingTXPoolKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// AddToOutgoingPool locks the sender's coins in the module account and
+// queues an OutgoingTransferTx for inclusion in the next batch for its
+// denom, returning the assigned transfer ID
+func (k Keeper) AddToOutgoingPool(ctx sdk.Context, sender sdk.AccAddress, destAddress string, amount sdk.Coin, bridgeFee sdk.Coin) (uint64, error) {
+	if _, registered := k.GetERC20ForDenom(ctx, amount.Denom); !registered {
+		return 0, sdkerrors.Wrapf(types.ErrUnknown, "denom %s has no registered ERC20", amount.Denom)
+	}
+
+	total := amount.Add(bridgeFee)
+	if err := k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(total)); err != nil {
+		return 0, sdkerrors.Wrap(err, "transfer to module account")
+	}
+
+	id := k.nextPoolID(ctx)
+	tx := types.OutgoingTransferTx{
+		ID:          id,
+		Sender:      sender,
+		DestAddress: destAddress,
+		Amount:      amount,
+		BridgeFee:   bridgeFee,
+	}
+	store := ctx.KVStore(k.StoreKey)
+	store.Set(getPoolTxKey(id), k.cdc.MustMarshalBinaryBare(tx))
+	return id, nil
+}
+
+func (k Keeper) nextPoolID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.StoreKey)
+	id := uint64(1)
+	if bz := store.Get(nextPoolIDKey); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(nextPoolIDKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
+
+// IterateOutgoingPool iterates over every unbatched OutgoingTransferTx
+func (k Keeper) IterateOutgoingPool(ctx sdk.Context, cb func(tx types.OutgoingTransferTx) (stop bool)) {
+	store := ctx.KVStore(k.StoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.OutgoingTXPoolKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if bytesEqual(iter.Key(), nextPoolIDKey) {
+			continue
+		}
+		var tx types.OutgoingTransferTx
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &tx)
+		if cb(tx) {
+			break
+		}
+	}
+}
+
+func (k Keeper) removeFromPool(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Delete(getPoolTxKey(id))
+}
+
+func bytesEqual(a, b []byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// BuildOutgoingTxBatch drains up to OutgoingTxBatchSize pending transfers
+// for the given denom out of the pool and packs them into a new
+// OutgoingTxBatch, ready for validators to confirm. Callers are expected to
+// have already resolved tokenContract from the ERC20 registry: this
+// function does not consult it.
+func (k Keeper) BuildOutgoingTxBatch(ctx sdk.Context, tokenContract string, denom string, timeout uint64) (types.OutgoingTxBatch, error) {
+	var selected []types.OutgoingTransferTx
+	k.IterateOutgoingPool(ctx, func(tx types.OutgoingTransferTx) bool {
+		if tx.Amount.Denom != denom {
+			return false
+		}
+		selected = append(selected, tx)
+		return len(selected) >= OutgoingTxBatchSize
+	})
+	if len(selected) == 0 {
+		return types.OutgoingTxBatch{}, sdkerrors.Wrap(types.ErrEmpty, "no pending transfers for denom")
+	}
+
+	nonce := k.nextBatchNonce(ctx)
+	batch := types.OutgoingTxBatch{
+		BatchNonce:    nonce,
+		BatchTimeout:  timeout,
+		Transactions:  selected,
+		TokenContract: tokenContract,
+		Block:         uint64(ctx.BlockHeight()),
+		Members:       k.bondedBridgeValidators(ctx),
+	}
+	k.StoreBatch(ctx, batch)
+	for _, tx := range selected {
+		k.removeFromPool(ctx, tx.ID)
+	}
+	return batch, nil
+}
+
+// GetNextBatchNonce returns the nonce BuildOutgoingTxBatch will assign the
+// next batch it builds, without consuming it
+func (k Keeper) GetNextBatchNonce(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.StoreKey)
+	if bz := store.Get(types.NextBatchNonceKey); bz != nil {
+		return sdk.BigEndianToUint64(bz)
+	}
+	return 1
+}
+
+// setNextBatchNonce sets the nonce BuildOutgoingTxBatch will assign the
+// next batch it builds. It exists for InitGenesis to restore the counter
+// ExportGenesis captured, so a restarted chain doesn't reassign a nonce
+// that already identifies an imported batch.
+func (k Keeper) setNextBatchNonce(ctx sdk.Context, nonce uint64) {
+	ctx.KVStore(k.StoreKey).Set(types.NextBatchNonceKey, sdk.Uint64ToBigEndian(nonce))
+}
+
+func (k Keeper) nextBatchNonce(ctx sdk.Context) uint64 {
+	nonce := k.GetNextBatchNonce(ctx)
+	k.setNextBatchNonce(ctx, nonce+1)
+	return nonce
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+)
+
+// TestIterateOutgoingTxBatchesSkipsNonceCounter asserts that the nonce
+// counter BuildOutgoingTxBatch persists lives under its own key prefix, so
+// IterateOutgoingTxBatches never trips over it and tries to unmarshal it as
+// an OutgoingTxBatch
+func TestIterateOutgoingTxBatchesSkipsNonceCounter(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	require.NoError(t, k.RegisterERC20(ctx, "uatom", "0x1111111111111111111111111111111111111111"))
+	sender := sdk.AccAddress([]byte("sender--------------"))
+
+	_, err := k.AddToOutgoingPool(ctx, sender, "0x2222222222222222222222222222222222222222", sdk.NewInt64Coin("uatom", 100), sdk.NewInt64Coin("uatom", 1))
+	require.NoError(t, err)
+	first, err := k.BuildOutgoingTxBatch(ctx, "0x1111111111111111111111111111111111111111", "uatom", 500)
+	require.NoError(t, err)
+
+	_, err = k.AddToOutgoingPool(ctx, sender, "0x2222222222222222222222222222222222222222", sdk.NewInt64Coin("uatom", 100), sdk.NewInt64Coin("uatom", 1))
+	require.NoError(t, err)
+	second, err := k.BuildOutgoingTxBatch(ctx, "0x1111111111111111111111111111111111111111", "uatom", 500)
+	require.NoError(t, err)
+
+	var seen []uint64
+	require.NotPanics(t, func() {
+		k.IterateOutgoingTxBatches(ctx, func(batch types.OutgoingTxBatch) bool {
+			seen = append(seen, batch.BatchNonce)
+			return false
+		})
+	})
+	require.ElementsMatch(t, []uint64{first.BatchNonce, second.BatchNonce}, seen)
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+)
+
+// Legacy query routes, served over the amino-era ABCI query path. This
+// module has no gRPC Query service (see types.QueryRouter's doc comment).
+// The params route reads the Keeper directly; the valset and batch routes
+// are thin adapters over the plain Querier methods in query.go.
+const (
+	QueryParams         = "params"
+	QueryCurrentValset  = "current-valset"
+	QueryPendingBatches = "pending-batches"
+)
+
+// NewQuerier returns the legacy amino query router for the peggy module
+func NewQuerier(k Keeper, legacyQuerierCdc *codec.Codec) sdk.Querier {
+	querier := Querier{Keeper: k}
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		grpcCtx := sdk.WrapSDKContext(ctx)
+		switch path[0] {
+		case QueryParams:
+			return queryParams(ctx, k, legacyQuerierCdc)
+		case QueryCurrentValset:
+			res, err := querier.CurrentValset(grpcCtx, &types.QueryCurrentValsetRequest{})
+			if err != nil {
+				return nil, err
+			}
+			return marshalLegacy(legacyQuerierCdc, res.Valset)
+		case QueryPendingBatches:
+			res, err := querier.PendingBatches(grpcCtx, &types.QueryPendingBatchesRequest{})
+			if err != nil {
+				return nil, err
+			}
+			return marshalLegacy(legacyQuerierCdc, res.Batches)
+		default:
+			return nil, sdkerrors.Wrapf(types.ErrUnknown, "unknown peggy query endpoint %s", path[0])
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.Codec) ([]byte, error) {
+	return marshalLegacy(legacyQuerierCdc, k.GetParams(ctx))
+}
+
+func marshalLegacy(cdc *codec.Codec, v interface{}) ([]byte, error) {
+	bz, err := codec.MarshalJSONIndent(cdc, v)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+)
+
+// Querier implements types.QueryRouter over a Keeper
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryRouter = Querier{}
+
+func (k Querier) CurrentValset(c context.Context, _ *types.QueryCurrentValsetRequest) (*types.QueryCurrentValsetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryCurrentValsetResponse{Valset: k.Keeper.CurrentValset(ctx)}, nil
+}
+
+func (k Querier) PendingBatches(c context.Context, _ *types.QueryPendingBatchesRequest) (*types.QueryPendingBatchesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var batches []types.OutgoingTxBatch
+	k.IterateOutgoingTxBatches(ctx, func(batch types.OutgoingTxBatch) bool {
+		batches = append(batches, batch)
+		return false
+	})
+	return &types.QueryPendingBatchesResponse{Batches: batches}, nil
+}
+
+func (k Querier) BatchConfirms(c context.Context, req *types.QueryBatchConfirmsRequest) (*types.QueryBatchConfirmsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var confirms []types.BatchConfirm
+	k.IterateBatchConfirms(ctx, func(confirm types.BatchConfirm) bool {
+		if confirm.Nonce == req.Nonce && confirm.TokenContract == req.TokenContract {
+			confirms = append(confirms, confirm)
+		}
+		return false
+	})
+	return &types.QueryBatchConfirmsResponse{Confirms: confirms}, nil
+}
+
+func (k Querier) ValsetConfirms(c context.Context, req *types.QueryValsetConfirmsRequest) (*types.QueryValsetConfirmsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var confirms []types.ValsetConfirm
+	k.IterateValsetConfirms(ctx, func(confirm types.ValsetConfirm) bool {
+		if confirm.Nonce == req.Nonce {
+			confirms = append(confirms, confirm)
+		}
+		return false
+	})
+	return &types.QueryValsetConfirmsResponse{Confirms: confirms}, nil
+}
+
+func (k Querier) DenomToERC20(c context.Context, req *types.QueryDenomToERC20Request) (*types.QueryDenomToERC20Response, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	erc20Address, found := k.GetERC20ForDenom(ctx, req.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknown, "denom %s", req.Denom)
+	}
+	return &types.QueryDenomToERC20Response{ERC20Address: erc20Address}, nil
+}
+
+func (k Querier) ERC20ToDenom(c context.Context, req *types.QueryERC20ToDenomRequest) (*types.QueryERC20ToDenomResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	denom, found := k.GetDenomForERC20(ctx, req.ERC20Address)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknown, "erc20 %s", req.ERC20Address)
+	}
+	return &types.QueryERC20ToDenomResponse{Denom: denom}, nil
+}
+
+func (k Querier) PendingLogicCalls(c context.Context, _ *types.QueryPendingLogicCallsRequest) (*types.QueryPendingLogicCallsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var calls []types.OutgoingLogicCall
+	k.IterateLogicCalls(ctx, func(call types.OutgoingLogicCall) bool {
+		calls = append(calls, call)
+		return false
+	})
+	return &types.QueryPendingLogicCallsResponse{Calls: calls}, nil
+}
+
+func (k Querier) LogicCallConfirms(c context.Context, req *types.QueryLogicCallConfirmsRequest) (*types.QueryLogicCallConfirmsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var confirms []types.LogicCallConfirm
+	k.IterateLogicCallConfirms(ctx, func(confirm types.LogicCallConfirm) bool {
+		if confirm.InvalidationNonce == req.InvalidationNonce && bytes.Equal(confirm.InvalidationID, req.InvalidationID) {
+			confirms = append(confirms, confirm)
+		}
+		return false
+	})
+	return &types.QueryLogicCallConfirmsResponse{Confirms: confirms}, nil
+}
+
+func (k Querier) PendingSendToEth(c context.Context, req *types.QueryPendingSendToEthRequest) (*types.QueryPendingSendToEthResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, err := sdk.AccAddressFromBech32(req.SenderAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender_address")
+	}
+
+	resp := &types.QueryPendingSendToEthResponse{}
+	k.IterateOutgoingPool(ctx, func(tx types.OutgoingTransferTx) bool {
+		if tx.Sender.Equals(sender) {
+			resp.UnbatchedTransfers = append(resp.UnbatchedTransfers, tx)
+		}
+		return false
+	})
+	k.IterateOutgoingTxBatches(ctx, func(batch types.OutgoingTxBatch) bool {
+		for _, tx := range batch.Transactions {
+			if tx.Sender.Equals(sender) {
+				resp.TransfersInBatches = append(resp.TransfersInBatches, tx)
+			}
+		}
+		return false
+	})
+	return resp, nil
+}
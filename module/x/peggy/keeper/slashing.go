@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+)
+
+// EndBlocker runs the four peggy slashing passes every block: unsigned
+// valsets, unsigned batches, unsigned logic calls, and validators falling
+// behind on Ethereum event attestation. It is wired into the module's
+// EndBlock.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	params := k.GetParams(ctx)
+	SlashUnsignedValsets(ctx, k, params)
+	SlashUnsignedBatches(ctx, k, params)
+	SlashUnsignedLogicCalls(ctx, k, params)
+	SlashLaggingClaims(ctx, k, params)
+}
+
+// ethAddressToValidator builds a reverse index of every bound Ethereum
+// address to the validator operator it belongs to, for turning the
+// EthAddress-keyed members of a Valset back into slashable validators
+func ethAddressToValidator(ctx sdk.Context, k Keeper) map[string]sdk.ValAddress {
+	byEthAddress := make(map[string]sdk.ValAddress)
+	k.IterateEthAddresses(ctx, func(validator sdk.ValAddress, ethAddress string) bool {
+		byEthAddress[types.NormalizeEthAddress(ethAddress)] = validator
+		return false
+	})
+	return byEthAddress
+}
+
+// SlashUnsignedValsets slashes and jails every validator that was a member
+// of a Valset still unconfirmed after params.SignedValsetsWindow blocks.
+// Valsets within the window are left alone: their signing period has not
+// elapsed yet.
+func SlashUnsignedValsets(ctx sdk.Context, k Keeper, params types.Params) {
+	currentHeight := uint64(ctx.BlockHeight())
+	byEthAddress := ethAddressToValidator(ctx, k)
+
+	k.IterateValsets(ctx, func(valset types.Valset) bool {
+		if currentHeight <= valset.Height+params.SignedValsetsWindow {
+			return false
+		}
+
+		confirmed := make(map[string]bool)
+		k.IterateValsetConfirms(ctx, func(confirm types.ValsetConfirm) bool {
+			if confirm.Nonce == valset.Nonce {
+				confirmed[sdk.ValAddress(confirm.Orchestrator).String()] = true
+			}
+			return false
+		})
+
+		for _, member := range valset.Members {
+			validator, found := byEthAddress[types.NormalizeEthAddress(member.EthAddress)]
+			if !found || confirmed[validator.String()] {
+				continue
+			}
+			key := types.GetSlashedValsetKey(valset.Nonce, validator)
+			if k.hasSlashed(ctx, key) {
+				continue
+			}
+			slashValidator(ctx, k, validator, params.SlashFractionValset, types.EventTypeSlashValsetUnsigned)
+			k.markSlashed(ctx, key)
+		}
+		return false
+	})
+}
+
+// SlashUnsignedBatches slashes and jails every validator that was bonded to
+// the bridge when a batch was created and never submitted a MsgConfirmBatch
+// for it, once it is still outstanding after params.SignedBatchesWindow
+// blocks. It holds validators to the Members snapshot taken when the batch
+// was built (see OutgoingTxBatch.Members), not whoever is bonded when the
+// window elapses, so a validator that bonds after the batch was requested
+// is never slashed for missing a signature it never had the chance to give.
+func SlashUnsignedBatches(ctx sdk.Context, k Keeper, params types.Params) {
+	currentHeight := uint64(ctx.BlockHeight())
+	byEthAddress := ethAddressToValidator(ctx, k)
+
+	k.IterateOutgoingTxBatches(ctx, func(batch types.OutgoingTxBatch) bool {
+		if currentHeight <= batch.Block+params.SignedBatchesWindow {
+			return false
+		}
+
+		confirmed := make(map[string]bool)
+		k.IterateBatchConfirms(ctx, func(confirm types.BatchConfirm) bool {
+			if confirm.TokenContract == batch.TokenContract && confirm.Nonce == batch.BatchNonce {
+				confirmed[sdk.ValAddress(confirm.Orchestrator).String()] = true
+			}
+			return false
+		})
+
+		for _, member := range batch.Members {
+			validator, found := byEthAddress[types.NormalizeEthAddress(member.EthAddress)]
+			if !found || confirmed[validator.String()] {
+				continue
+			}
+			key := types.GetSlashedBatchKey(batch.TokenContract, batch.BatchNonce, validator)
+			if k.hasSlashed(ctx, key) {
+				continue
+			}
+			slashValidator(ctx, k, validator, params.SlashFractionBatch, types.EventTypeSlashBatchUnsigned)
+			k.markSlashed(ctx, key)
+		}
+		return false
+	})
+}
+
+// SlashUnsignedLogicCalls slashes and jails every validator that was bonded
+// to the bridge when a logic call was created and never submitted a
+// MsgConfirmLogicCall for it, once it is still outstanding after
+// params.SignedLogicCallsWindow blocks. Like SlashUnsignedBatches, it holds
+// validators to the Members snapshot taken at creation time rather than the
+// currently bonded set.
+func SlashUnsignedLogicCalls(ctx sdk.Context, k Keeper, params types.Params) {
+	currentHeight := uint64(ctx.BlockHeight())
+	byEthAddress := ethAddressToValidator(ctx, k)
+
+	k.IterateLogicCalls(ctx, func(call types.OutgoingLogicCall) bool {
+		if currentHeight <= call.Block+params.SignedLogicCallsWindow {
+			return false
+		}
+
+		confirmed := make(map[string]bool)
+		k.IterateLogicCallConfirms(ctx, func(confirm types.LogicCallConfirm) bool {
+			if bytes.Equal(confirm.InvalidationID, call.InvalidationID) && confirm.InvalidationNonce == call.InvalidationNonce {
+				confirmed[sdk.ValAddress(confirm.Orchestrator).String()] = true
+			}
+			return false
+		})
+
+		for _, member := range call.Members {
+			validator, found := byEthAddress[types.NormalizeEthAddress(member.EthAddress)]
+			if !found || confirmed[validator.String()] {
+				continue
+			}
+			key := types.GetSlashedLogicCallKey(call.InvalidationID, call.InvalidationNonce, validator)
+			if k.hasSlashed(ctx, key) {
+				continue
+			}
+			slashValidator(ctx, k, validator, params.SlashFractionLogicCall, types.EventTypeSlashLogicCallUnsigned)
+			k.markSlashed(ctx, key)
+		}
+		return false
+	})
+}
+
+// SlashLaggingClaims slashes every currently bonded, bridge-registered
+// validator whose last observed event nonce trails the module's highest
+// observed nonce, across every claim type, by more than
+// params.SignedClaimsWindow. A validator that has never submitted a claim
+// counts as having last nonce 0, so it is slashed too once the highest
+// observed nonce exceeds the window.
+func SlashLaggingClaims(ctx sdk.Context, k Keeper, params types.Params) {
+	var highestObserved uint64
+	for _, claimType := range allClaimTypes {
+		if nonce := k.GetLastObservedEventNonce(ctx, claimType); nonce > highestObserved {
+			highestObserved = nonce
+		}
+	}
+	if highestObserved <= params.SignedClaimsWindow {
+		return
+	}
+
+	k.StakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingexported.ValidatorI) bool {
+		operator := validator.GetOperator()
+		if k.GetEthAddress(ctx, operator) == "" {
+			return false
+		}
+		if k.GetLastEventNonceByValidator(ctx, operator)+params.SignedClaimsWindow < highestObserved {
+			slashValidator(ctx, k, operator, params.SlashFractionClaim, types.EventTypeSlashClaimLagging)
+		}
+		return false
+	})
+}
+
+// hasSlashed reports whether the (artifact, validator) pair behind key has
+// already been penalized, so repeated EndBlocker passes over the same
+// still-unsigned Valset/batch/logic call don't slash it again every block
+func (k Keeper) hasSlashed(ctx sdk.Context, key []byte) bool {
+	return ctx.KVStore(k.StoreKey).Has(key)
+}
+
+// markSlashed records that the (artifact, validator) pair behind key has
+// been penalized
+func (k Keeper) markSlashed(ctx sdk.Context, key []byte) {
+	ctx.KVStore(k.StoreKey).Set(key, []byte{1})
+}
+
+// slashValidator slashes and jails a single validator, emitting the given
+// event type so relayers and block explorers can surface why
+func slashValidator(ctx sdk.Context, k Keeper, operator sdk.ValAddress, fraction sdk.Dec, eventType string) {
+	validator := k.StakingKeeper.Validator(ctx, operator)
+	if validator == nil {
+		return
+	}
+	consAddr := validator.GetConsAddr()
+
+	k.SlashingKeeper.Slash(ctx, consAddr, fraction, validator.GetConsensusPower(), ctx.BlockHeight())
+	k.SlashingKeeper.Jail(ctx, consAddr)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeKeyValidator, operator.String()),
+	))
+}
@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/althea-net/peggy/module/x/peggy/types/mocks"
+)
+
+// consAddrValidator is a fakeValidator extended with the consensus address
+// lookup the slashing pass needs
+type consAddrValidator struct {
+	fakeValidator
+	consAddr sdk.ConsAddress
+}
+
+func (v consAddrValidator) GetConsAddr() sdk.ConsAddress { return v.consAddr }
+
+// TestSlashUnsignedValsetsOnlySlashesNonSigners asserts that
+// SlashUnsignedValsets punishes exactly the Valset member whose orchestrator
+// never submitted a MsgValsetConfirm once the signing window has elapsed
+func TestSlashUnsignedValsetsOnlySlashesNonSigners(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	signer := sdk.ValAddress([]byte("signer-validator----"))
+	absent := sdk.ValAddress([]byte("absent-validator----"))
+	absentConsAddr := sdk.ConsAddress([]byte("absent-consensus----"))
+
+	k.SetEthAddress(ctx, signer, "0x1111111111111111111111111111111111111111")
+	k.SetEthAddress(ctx, absent, "0x2222222222222222222222222222222222222222")
+
+	k.StoreValset(ctx, types.Valset{
+		Nonce:  1,
+		Height: 0,
+		Members: []types.BridgeValidator{
+			{Power: 100, EthAddress: "0x1111111111111111111111111111111111111111"},
+			{Power: 50, EthAddress: "0x2222222222222222222222222222222222222222"},
+		},
+	})
+	k.SetValsetConfirm(ctx, types.ValsetConfirm{Nonce: 1, Orchestrator: sdk.AccAddress(signer)})
+
+	params := k.GetParams(ctx)
+	ctx = ctx.WithBlockHeight(int64(params.SignedValsetsWindow) + 1)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stakingKeeper := mocks.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().Validator(ctx, absent).Return(consAddrValidator{
+		fakeValidator: fakeValidator{operator: absent, power: 50},
+		consAddr:      absentConsAddr,
+	})
+	k.StakingKeeper = stakingKeeper
+
+	slashingKeeper := mocks.NewMockSlashingKeeper(ctrl)
+	slashingKeeper.EXPECT().Slash(ctx, absentConsAddr, params.SlashFractionValset, int64(50), ctx.BlockHeight())
+	slashingKeeper.EXPECT().Jail(ctx, absentConsAddr)
+	k.SlashingKeeper = slashingKeeper
+
+	SlashUnsignedValsets(ctx, k, params)
+}
+
+// TestSlashUnsignedLogicCallsOnlySlashesNonSigners asserts that
+// SlashUnsignedLogicCalls punishes exactly the bonded, bridge-registered
+// validator that never submitted a MsgConfirmLogicCall once the signing
+// window has elapsed
+func TestSlashUnsignedLogicCallsOnlySlashesNonSigners(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	signer := sdk.ValAddress([]byte("signer-validator----"))
+	absent := sdk.ValAddress([]byte("absent-validator----"))
+	absentConsAddr := sdk.ConsAddress([]byte("absent-consensus----"))
+
+	k.SetEthAddress(ctx, signer, "0x1111111111111111111111111111111111111111")
+	k.SetEthAddress(ctx, absent, "0x2222222222222222222222222222222222222222")
+
+	invalidationID := []byte("invalidation-id-1")
+	require.NoError(t, k.SetLogicCall(ctx, types.OutgoingLogicCall{
+		LogicContractAddress: "0x3333333333333333333333333333333333333333",
+		InvalidationID:       invalidationID,
+		InvalidationNonce:    1,
+		Block:                0,
+		Members: []types.BridgeValidator{
+			{Power: 100, EthAddress: "0x1111111111111111111111111111111111111111"},
+			{Power: 50, EthAddress: "0x2222222222222222222222222222222222222222"},
+		},
+	}))
+	k.SetLogicCallConfirm(ctx, types.LogicCallConfirm{
+		InvalidationID:    invalidationID,
+		InvalidationNonce: 1,
+		Orchestrator:      sdk.AccAddress(signer),
+	})
+
+	params := k.GetParams(ctx)
+	ctx = ctx.WithBlockHeight(int64(params.SignedLogicCallsWindow) + 1)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stakingKeeper := mocks.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().Validator(ctx, absent).Return(consAddrValidator{
+		fakeValidator: fakeValidator{operator: absent, power: 50},
+		consAddr:      absentConsAddr,
+	})
+	k.StakingKeeper = stakingKeeper
+
+	slashingKeeper := mocks.NewMockSlashingKeeper(ctrl)
+	slashingKeeper.EXPECT().Slash(ctx, absentConsAddr, params.SlashFractionLogicCall, int64(50), ctx.BlockHeight())
+	slashingKeeper.EXPECT().Jail(ctx, absentConsAddr)
+	k.SlashingKeeper = slashingKeeper
+
+	SlashUnsignedLogicCalls(ctx, k, params)
+}
+
+// TestSlashLaggingClaimsOnlySlashesValidatorsBehindHighestObservedNonce
+// asserts that SlashLaggingClaims punishes exactly the bonded,
+// bridge-registered validator whose last submitted event nonce trails the
+// highest nonce any claim type has observed by more than
+// params.SignedClaimsWindow
+func TestSlashLaggingClaimsOnlySlashesValidatorsBehindHighestObservedNonce(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	current := sdk.ValAddress([]byte("current-validator---"))
+	lagging := sdk.ValAddress([]byte("lagging-validator---"))
+	laggingConsAddr := sdk.ConsAddress([]byte("lagging-consensus---"))
+
+	k.SetEthAddress(ctx, current, "0x1111111111111111111111111111111111111111")
+	k.SetEthAddress(ctx, lagging, "0x2222222222222222222222222222222222222222")
+
+	params := k.GetParams(ctx)
+	highestObserved := params.SignedClaimsWindow + 10
+	k.SetLastObservedEventNonce(ctx, types.ClaimTypeEthereumBridgeDeposit, highestObserved)
+	k.SetLastEventNonceByValidator(ctx, current, highestObserved)
+	k.SetLastEventNonceByValidator(ctx, lagging, 1)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stakingKeeper := mocks.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().IterateBondedValidatorsByPower(ctx, gomock.Any()).Do(
+		func(_ sdk.Context, fn func(int64, stakingexported.ValidatorI) bool) {
+			fn(0, consAddrValidator{
+				fakeValidator: fakeValidator{operator: current, power: 100},
+				consAddr:      sdk.ConsAddress([]byte("current-consensus---")),
+			})
+			fn(1, consAddrValidator{
+				fakeValidator: fakeValidator{operator: lagging, power: 50},
+				consAddr:      laggingConsAddr,
+			})
+		})
+	stakingKeeper.EXPECT().Validator(ctx, lagging).Return(consAddrValidator{
+		fakeValidator: fakeValidator{operator: lagging, power: 50},
+		consAddr:      laggingConsAddr,
+	})
+	k.StakingKeeper = stakingKeeper
+
+	slashingKeeper := mocks.NewMockSlashingKeeper(ctrl)
+	slashingKeeper.EXPECT().Slash(ctx, laggingConsAddr, params.SlashFractionClaim, int64(50), ctx.BlockHeight())
+	slashingKeeper.EXPECT().Jail(ctx, laggingConsAddr)
+	k.SlashingKeeper = slashingKeeper
+
+	SlashLaggingClaims(ctx, k, params)
+}
+
+// TestSlashLaggingClaimsCountsERC20DeployedNonce asserts that
+// SlashLaggingClaims' highestObserved computation includes
+// ClaimTypeERC20Deployed, so a validator that stops attesting ERC20
+// deployments specifically is still slashed for lagging even though every
+// other claim type's last-observed nonce stays at zero.
+func TestSlashLaggingClaimsCountsERC20DeployedNonce(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	current := sdk.ValAddress([]byte("current-validator---"))
+	lagging := sdk.ValAddress([]byte("lagging-validator---"))
+	laggingConsAddr := sdk.ConsAddress([]byte("lagging-consensus---"))
+
+	k.SetEthAddress(ctx, current, "0x1111111111111111111111111111111111111111")
+	k.SetEthAddress(ctx, lagging, "0x2222222222222222222222222222222222222222")
+
+	params := k.GetParams(ctx)
+	highestObserved := params.SignedClaimsWindow + 10
+	k.SetLastObservedEventNonce(ctx, types.ClaimTypeERC20Deployed, highestObserved)
+	k.SetLastEventNonceByValidator(ctx, current, highestObserved)
+	k.SetLastEventNonceByValidator(ctx, lagging, 1)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stakingKeeper := mocks.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().IterateBondedValidatorsByPower(ctx, gomock.Any()).Do(
+		func(_ sdk.Context, fn func(int64, stakingexported.ValidatorI) bool) {
+			fn(0, consAddrValidator{
+				fakeValidator: fakeValidator{operator: current, power: 100},
+				consAddr:      sdk.ConsAddress([]byte("current-consensus---")),
+			})
+			fn(1, consAddrValidator{
+				fakeValidator: fakeValidator{operator: lagging, power: 50},
+				consAddr:      laggingConsAddr,
+			})
+		})
+	stakingKeeper.EXPECT().Validator(ctx, lagging).Return(consAddrValidator{
+		fakeValidator: fakeValidator{operator: lagging, power: 50},
+		consAddr:      laggingConsAddr,
+	})
+	k.StakingKeeper = stakingKeeper
+
+	slashingKeeper := mocks.NewMockSlashingKeeper(ctrl)
+	slashingKeeper.EXPECT().Slash(ctx, laggingConsAddr, params.SlashFractionClaim, int64(50), ctx.BlockHeight())
+	slashingKeeper.EXPECT().Jail(ctx, laggingConsAddr)
+	k.SlashingKeeper = slashingKeeper
+
+	SlashLaggingClaims(ctx, k, params)
+}
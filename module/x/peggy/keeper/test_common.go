@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+)
+
+// setupTestKeeper spins up an in-memory peggy Keeper backed by a fresh
+// MemDB, for use by this package's unit tests. The expected-keeper fields
+// are backed by no-op stubs (see mocks_test.go), enough for tests that do
+// not depend on what bank, staking, supply or slashing return. Tests that
+// do (valset_test.go, msg_router_test.go, slashing_test.go) swap in gomock
+// mocks with their own expectations.
+func setupTestKeeper(t *testing.T) (sdk.Context, Keeper) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tparamsKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+
+	db := tmdb.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tparamsKey, storetypes.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+
+	pk := paramskeeper.NewKeeper(cdc, paramsKey, tparamsKey)
+	subspace := pk.Subspace(types.ModuleName)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	authority := sdk.AccAddress([]byte("gov-module-account--"))
+	k := NewKeeper(cdc, storeKey, subspace, stubStakingKeeper{}, stubBankKeeper{}, stubSupplyKeeper{}, stubSlashingKeeper{}, authority)
+	return ctx, k
+}
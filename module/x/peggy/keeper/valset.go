@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+)
+
+// bondedBridgeValidators builds the bridge-registered member list for the
+// currently bonded validator set, pulling each validator's bridge voting
+// power from the staking keeper and its Ethereum address from the eth
+// address store. Validators that have not yet submitted a
+// MsgSetEthAddress are skipped: they cannot be part of the bridge
+// multisig until they do. CurrentValset uses this directly, and
+// BuildOutgoingTxBatch/SubmitLogicCall snapshot it onto the batch/logic
+// call they create so the slashing pass has the membership that was
+// actually bonded at creation time, not whoever is bonded when the
+// signing window later elapses.
+func (k Keeper) bondedBridgeValidators(ctx sdk.Context) []types.BridgeValidator {
+	var members []types.BridgeValidator
+	k.StakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingexported.ValidatorI) bool {
+		ethAddr := k.GetEthAddress(ctx, validator.GetOperator())
+		if ethAddr == "" {
+			return false
+		}
+		members = append(members, types.BridgeValidator{
+			Power:      uint64(validator.GetConsensusPower()),
+			EthAddress: ethAddr,
+		})
+		return false
+	})
+	return members
+}
+
+// GetNextValsetNonce returns the nonce CurrentValset will assign the next
+// Valset it builds, without consuming it
+func (k Keeper) GetNextValsetNonce(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.StoreKey)
+	if bz := store.Get(types.NextValsetNonceKey); bz != nil {
+		return sdk.BigEndianToUint64(bz)
+	}
+	return 1
+}
+
+// setNextValsetNonce sets the nonce CurrentValset will assign the next
+// Valset it builds. It exists for InitGenesis to restore the counter
+// ExportGenesis captured, so a restarted chain doesn't reassign a nonce
+// that already identifies an imported Valset.
+func (k Keeper) setNextValsetNonce(ctx sdk.Context, nonce uint64) {
+	ctx.KVStore(k.StoreKey).Set(types.NextValsetNonceKey, sdk.Uint64ToBigEndian(nonce))
+}
+
+// CurrentValset builds a fresh Valset from the currently bonded validator
+// set, assigning it the next valset nonce
+func (k Keeper) CurrentValset(ctx sdk.Context) types.Valset {
+	nonce := k.GetNextValsetNonce(ctx)
+	k.setNextValsetNonce(ctx, nonce+1)
+
+	return types.Valset{
+		Nonce:   nonce,
+		Members: k.bondedBridgeValidators(ctx),
+		Height:  uint64(ctx.BlockHeight()),
+	}
+}
+
+// StoreValset persists a Valset by its nonce
+func (k Keeper) StoreValset(ctx sdk.Context, valset types.Valset) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Set(types.GetValsetKey(valset.Nonce), k.cdc.MustMarshalBinaryBare(valset))
+}
+
+// GetValset returns a Valset by nonce, or false if it does not exist
+func (k Keeper) GetValset(ctx sdk.Context, nonce uint64) (types.Valset, bool) {
+	store := ctx.KVStore(k.StoreKey)
+	bz := store.Get(types.GetValsetKey(nonce))
+	if bz == nil {
+		return types.Valset{}, false
+	}
+	var valset types.Valset
+	k.cdc.MustUnmarshalBinaryBare(bz, &valset)
+	return valset, true
+}
+
+// IterateValsets iterates over every historical Valset in the store
+func (k Keeper) IterateValsets(ctx sdk.Context, cb func(valset types.Valset) (stop bool)) {
+	store := ctx.KVStore(k.StoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValsetRequestKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var valset types.Valset
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &valset)
+		if cb(valset) {
+			break
+		}
+	}
+}
+
+// SetValsetConfirm persists a validator's Ethereum signature over a Valset
+func (k Keeper) SetValsetConfirm(ctx sdk.Context, confirm types.ValsetConfirm) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Set(types.GetValsetConfirmKey(confirm.Nonce, confirm.Orchestrator), k.cdc.MustMarshalBinaryBare(confirm))
+}
+
+// IterateValsetConfirms iterates over every stored valset confirmation
+func (k Keeper) IterateValsetConfirms(ctx sdk.Context, cb func(confirm types.ValsetConfirm) (stop bool)) {
+	store := ctx.KVStore(k.StoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValsetConfirmKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var confirm types.ValsetConfirm
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &confirm)
+		if cb(confirm) {
+			break
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/althea-net/peggy/module/x/peggy/types/mocks"
+)
+
+// fakeValidator implements stakingexported.ValidatorI by embedding a nil
+// interface and overriding only the two methods CurrentValset reads, so
+// the test doesn't need to stub the entire (large) validator interface.
+type fakeValidator struct {
+	stakingexported.ValidatorI
+	operator sdk.ValAddress
+	power    int64
+}
+
+func (f fakeValidator) GetOperator() sdk.ValAddress { return f.operator }
+func (f fakeValidator) GetConsensusPower() int64    { return f.power }
+
+// TestCurrentValsetSkipsValidatorsWithoutEthAddress asserts that bonded
+// validators who never submitted a MsgSetEthAddress are excluded from the
+// Valset, since they cannot be part of the bridge multisig
+func TestCurrentValsetSkipsValidatorsWithoutEthAddress(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	bound := sdk.ValAddress([]byte("bound-validator-----"))
+	unbound := sdk.ValAddress([]byte("unbound-validator---"))
+	k.SetEthAddress(ctx, bound, "0x1111111111111111111111111111111111111111")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	stakingKeeper := mocks.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().IterateBondedValidatorsByPower(ctx, gomock.Any()).Do(
+		func(_ sdk.Context, fn func(int64, stakingexported.ValidatorI) bool) {
+			fn(0, fakeValidator{operator: bound, power: 100})
+			fn(1, fakeValidator{operator: unbound, power: 50})
+		},
+	)
+	k.StakingKeeper = stakingKeeper
+
+	valset := k.CurrentValset(ctx)
+
+	require.Len(t, valset.Members, 1)
+	require.Equal(t, "0x1111111111111111111111111111111111111111", valset.Members[0].EthAddress)
+	require.Equal(t, uint64(100), valset.Members[0].Power)
+}
+
+// TestIterateValsetsSkipsNonceCounter asserts that the nonce counter
+// CurrentValset persists lives under its own key prefix, so IterateValsets
+// never trips over it and tries to unmarshal it as a Valset
+func TestIterateValsetsSkipsNonceCounter(t *testing.T) {
+	ctx, k := setupTestKeeper(t)
+
+	first := k.CurrentValset(ctx)
+	k.StoreValset(ctx, first)
+	second := k.CurrentValset(ctx)
+	k.StoreValset(ctx, second)
+
+	var seen []uint64
+	require.NotPanics(t, func() {
+		k.IterateValsets(ctx, func(valset types.Valset) bool {
+			seen = append(seen, valset.Nonce)
+			return false
+		})
+	})
+	require.ElementsMatch(t, []uint64{first.Nonce, second.Nonce}, seen)
+}
@@ -0,0 +1,87 @@
+package peggy
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/althea-net/peggy/module/x/peggy/keeper"
+	"github.com/althea-net/peggy/module/x/peggy/types"
+)
+
+// This module wires peggy onto the legacy amino module.AppModuleBasic/
+// AppModule interfaces (DefaultGenesis/InitGenesis/NewHandler/
+// NewQuerierHandler below), not the Stargate module.AppModule/Configurator/
+// RegisterServices interfaces. There is no RegisterServices method here and
+// no gRPC service registered anywhere in this package; see
+// types.MsgRouter's doc comment for why.
+//
+// AppModuleBasic implements the module.AppModuleBasic interface for peggy
+type AppModuleBasic struct{}
+
+func (AppModuleBasic) Name() string { return types.ModuleName }
+
+func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
+	types.RegisterCodec(cdc)
+}
+
+func (AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return types.ModuleCdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
+	var data types.GenesisState
+	if err := types.ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return err
+	}
+	return types.ValidateGenesis(data)
+}
+
+func (AppModuleBasic) GetTxCmd(_ *codec.Codec) *cobra.Command    { return nil }
+func (AppModuleBasic) GetQueryCmd(_ *codec.Codec) *cobra.Command { return nil }
+
+// AppModule implements the module.AppModule interface for peggy
+type AppModule struct {
+	AppModuleBasic
+	keeper keeper.Keeper
+}
+
+// NewAppModule returns a new peggy AppModule wrapping the given keeper
+func NewAppModule(k keeper.Keeper) AppModule {
+	return AppModule{keeper: k}
+}
+
+func (AppModule) RegisterInvariants(sdk.InvariantRegistry) {}
+
+func (AppModule) Route() string { return types.RouterKey }
+
+func (am AppModule) NewHandler() sdk.Handler {
+	return NewHandler(am.keeper)
+}
+
+func (AppModule) QuerierRoute() string { return types.QuerierRoute }
+
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return keeper.NewQuerier(am.keeper, types.ModuleCdc)
+}
+
+func (am AppModule) InitGenesis(ctx sdk.Context, gs json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState types.GenesisState
+	types.ModuleCdc.MustUnmarshalJSON(gs, &genesisState)
+	keeper.InitGenesis(ctx, am.keeper, genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	return types.ModuleCdc.MustMarshalJSON(keeper.ExportGenesis(ctx, am.keeper))
+}
+
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {}
+
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	keeper.EndBlocker(ctx, am.keeper)
+	return []abci.ValidatorUpdate{}
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OutgoingTransferTx is a single leg of an OutgoingTxBatch: a withdrawal
+// from the bridge pool bound for an Ethereum address
+type OutgoingTransferTx struct {
+	ID          uint64         `json:"id"`
+	Sender      sdk.AccAddress `json:"sender"`
+	DestAddress string         `json:"dest_address"`
+	Amount      sdk.Coin       `json:"amount"`
+	BridgeFee   sdk.Coin       `json:"bridge_fee"`
+}
+
+// OutgoingTxBatch is a batch of OutgoingTransferTxs for a single ERC20
+// contract, ready to be relayed and executed on Ethereum
+type OutgoingTxBatch struct {
+	BatchNonce    uint64               `json:"batch_nonce"`
+	BatchTimeout  uint64               `json:"batch_timeout"`
+	Transactions  []OutgoingTransferTx `json:"transactions"`
+	TokenContract string               `json:"token_contract"`
+	Block         uint64               `json:"block"`
+	// Members is the bridge validator set bonded at the time this batch was
+	// created, so the slashing pass can hold validators to the membership
+	// that actually existed when signing was due rather than whoever is
+	// bonded when the signing window later elapses
+	Members []BridgeValidator `json:"members"`
+}
+
+// BatchConfirm is a validator's Ethereum signature over an OutgoingTxBatch's
+// checkpoint, keyed by (token contract, nonce, orchestrator) in the store
+type BatchConfirm struct {
+	Nonce         uint64         `json:"nonce"`
+	TokenContract string         `json:"token_contract"`
+	Orchestrator  sdk.AccAddress `json:"orchestrator"`
+	EthSigner     string         `json:"eth_signer"`
+	Signature     string         `json:"signature"`
+}
@@ -0,0 +1,121 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ClaimType distinguishes the kind of Ethereum event an EthereumClaim
+// attests to. Attestations are tallied per (event nonce, ClaimHash) pair,
+// and the last observed nonce is tracked per ClaimType.
+type ClaimType string
+
+const (
+	ClaimTypeEthereumBridgeDeposit         ClaimType = "bridge_deposit"
+	ClaimTypeEthereumBridgeWithdrawalBatch ClaimType = "bridge_withdrawal_batch"
+	ClaimTypeEthereumBridgeMultiSigUpdate  ClaimType = "bridge_multisig_update"
+)
+
+// EthereumClaim is an event observed on the Ethereum side of the bridge by
+// an orchestrator and submitted for attestation
+type EthereumClaim interface {
+	GetType() ClaimType
+	GetEventNonce() uint64
+	ValidateBasic() error
+	// ClaimHash uniquely identifies the claimed event's details so that
+	// differing claims for the same nonce can be tallied separately.
+	ClaimHash() []byte
+}
+
+var (
+	_ EthereumClaim = EthereumBridgeDepositClaim{}
+	_ EthereumClaim = EthereumBridgeWithdrawalBatchClaim{}
+	_ EthereumClaim = EthereumBridgeMultiSigUpdateClaim{}
+)
+
+// EthereumBridgeDepositClaim attests to an ERC20 (or ETH) deposit into the
+// bridge contract, to be credited to a Cosmos account
+type EthereumBridgeDepositClaim struct {
+	EventNonce     uint64   `json:"event_nonce"`
+	TokenContract  string   `json:"token_contract"`
+	Amount         sdk.Int  `json:"amount"`
+	EthereumSender string   `json:"ethereum_sender"`
+	CosmosReceiver sdk.AccAddress `json:"cosmos_receiver"`
+}
+
+func (e EthereumBridgeDepositClaim) GetType() ClaimType    { return ClaimTypeEthereumBridgeDeposit }
+func (e EthereumBridgeDepositClaim) GetEventNonce() uint64 { return e.EventNonce }
+
+func (e EthereumBridgeDepositClaim) ValidateBasic() error {
+	if err := ValidateEthAddress(e.TokenContract); err != nil {
+		return sdkerrors.Wrap(err, "token contract")
+	}
+	if err := ValidateEthAddress(e.EthereumSender); err != nil {
+		return sdkerrors.Wrap(err, "ethereum sender")
+	}
+	if e.CosmosReceiver.Empty() {
+		return sdkerrors.Wrap(ErrEmpty, "cosmos receiver")
+	}
+	if e.Amount.IsNil() || !e.Amount.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalid, "amount")
+	}
+	return nil
+}
+
+func (e EthereumBridgeDepositClaim) ClaimHash() []byte {
+	return ModuleCdc.MustMarshalBinaryBare(e)
+}
+
+// EthereumBridgeWithdrawalBatchClaim attests that an OutgoingTxBatch was
+// executed on Ethereum
+type EthereumBridgeWithdrawalBatchClaim struct {
+	EventNonce    uint64 `json:"event_nonce"`
+	BatchNonce    uint64 `json:"batch_nonce"`
+	TokenContract string `json:"token_contract"`
+}
+
+func (e EthereumBridgeWithdrawalBatchClaim) GetType() ClaimType {
+	return ClaimTypeEthereumBridgeWithdrawalBatch
+}
+func (e EthereumBridgeWithdrawalBatchClaim) GetEventNonce() uint64 { return e.EventNonce }
+
+func (e EthereumBridgeWithdrawalBatchClaim) ValidateBasic() error {
+	if err := ValidateEthAddress(e.TokenContract); err != nil {
+		return sdkerrors.Wrap(err, "token contract")
+	}
+	return nil
+}
+
+func (e EthereumBridgeWithdrawalBatchClaim) ClaimHash() []byte {
+	return ModuleCdc.MustMarshalBinaryBare(e)
+}
+
+// EthereumBridgeMultiSigUpdateClaim attests that a new Valset was pushed to
+// the bridge contract's multisig set on Ethereum
+type EthereumBridgeMultiSigUpdateClaim struct {
+	EventNonce  uint64 `json:"event_nonce"`
+	ValsetNonce uint64 `json:"valset_nonce"`
+}
+
+func (e EthereumBridgeMultiSigUpdateClaim) GetType() ClaimType {
+	return ClaimTypeEthereumBridgeMultiSigUpdate
+}
+func (e EthereumBridgeMultiSigUpdateClaim) GetEventNonce() uint64 { return e.EventNonce }
+
+func (e EthereumBridgeMultiSigUpdateClaim) ValidateBasic() error {
+	return nil
+}
+
+func (e EthereumBridgeMultiSigUpdateClaim) ClaimHash() []byte {
+	return ModuleCdc.MustMarshalBinaryBare(e)
+}
+
+// Attestation tracks which validators have submitted a matching claim for
+// a given Ethereum event nonce, and whether the claim has crossed the
+// voting power threshold and been applied to chain state.
+type Attestation struct {
+	EventNonce uint64           `json:"event_nonce"`
+	Claim      EthereumClaim    `json:"claim"`
+	Votes      []sdk.ValAddress `json:"votes"`
+	Observed   bool             `json:"observed"`
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ModuleCdc is the codec used by the peggy module for amino (de)serialization
+// of messages and genesis state. It is sealed in init, right after
+// RegisterCodec has registered the module's types on it.
+var ModuleCdc = codec.New()
+
+// RegisterCodec registers the peggy module's concrete message and claim
+// types with the given amino codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgSendToEth{}, "peggy/MsgSendToEth", nil)
+	cdc.RegisterConcrete(MsgRequestBatch{}, "peggy/MsgRequestBatch", nil)
+	cdc.RegisterConcrete(MsgConfirmBatch{}, "peggy/MsgConfirmBatch", nil)
+	cdc.RegisterConcrete(MsgSetEthAddress{}, "peggy/MsgSetEthAddress", nil)
+	cdc.RegisterConcrete(MsgValsetConfirm{}, "peggy/MsgValsetConfirm", nil)
+	cdc.RegisterConcrete(MsgValsetRequest{}, "peggy/MsgValsetRequest", nil)
+	cdc.RegisterConcrete(MsgCreateEthereumClaims{}, "peggy/MsgCreateEthereumClaims", nil)
+	cdc.RegisterConcrete(MsgBridgeSignatureSubmission{}, "peggy/MsgBridgeSignatureSubmission", nil)
+	cdc.RegisterConcrete(MsgDepositClaim{}, "peggy/MsgDepositClaim", nil)
+	cdc.RegisterConcrete(MsgWithdrawClaim{}, "peggy/MsgWithdrawClaim", nil)
+	cdc.RegisterConcrete(MsgValsetUpdateClaim{}, "peggy/MsgValsetUpdateClaim", nil)
+	cdc.RegisterConcrete(MsgRegisterERC20{}, "peggy/MsgRegisterERC20", nil)
+	cdc.RegisterConcrete(MsgDeployERC20{}, "peggy/MsgDeployERC20", nil)
+	cdc.RegisterConcrete(MsgERC20DeployedClaim{}, "peggy/MsgERC20DeployedClaim", nil)
+	cdc.RegisterConcrete(MsgSubmitLogicCall{}, "peggy/MsgSubmitLogicCall", nil)
+	cdc.RegisterConcrete(MsgConfirmLogicCall{}, "peggy/MsgConfirmLogicCall", nil)
+	cdc.RegisterConcrete(MsgLogicCallExecutedClaim{}, "peggy/MsgLogicCallExecutedClaim", nil)
+
+	cdc.RegisterInterface((*EthereumClaim)(nil), nil)
+	cdc.RegisterConcrete(EthereumBridgeDepositClaim{}, "peggy/EthereumBridgeDepositClaim", nil)
+	cdc.RegisterConcrete(EthereumBridgeWithdrawalBatchClaim{}, "peggy/EthereumBridgeWithdrawalBatchClaim", nil)
+	cdc.RegisterConcrete(EthereumBridgeMultiSigUpdateClaim{}, "peggy/EthereumBridgeMultiSigUpdateClaim", nil)
+}
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	ModuleCdc.Seal()
+}
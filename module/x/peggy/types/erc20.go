@@ -0,0 +1,11 @@
+package types
+
+// ERC20ToDenom is one entry in the ERC20<->denom registry: a bijection
+// between a Cosmos denom and the ERC20 contract address that backs it on
+// Ethereum. Both directions are enforced at write time so neither a denom
+// nor an ERC20 address can ever be bound to two different counterparts;
+// registering the same pair again is accepted.
+type ERC20ToDenom struct {
+	Denom         string `json:"denom"`
+	ERC20Address  string `json:"erc20_address"`
+}
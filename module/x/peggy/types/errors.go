@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/peggy module sentinel errors
+var (
+	ErrInvalid             = sdkerrors.Register(ModuleName, 2, "invalid")
+	ErrDuplicate           = sdkerrors.Register(ModuleName, 3, "duplicate")
+	ErrUnknown             = sdkerrors.Register(ModuleName, 4, "unknown")
+	ErrEmpty               = sdkerrors.Register(ModuleName, 5, "empty")
+	ErrOrphanConfirmation  = sdkerrors.Register(ModuleName, 6, "confirmation for a valset, batch or logic call that does not exist")
+	ErrDuplicateEthAddress = sdkerrors.Register(ModuleName, 7, "eth address already bound to a different validator")
+	ErrInvalidEthAddress   = sdkerrors.Register(ModuleName, 8, "invalid ethereum address")
+	ErrUnauthorized        = sdkerrors.Register(ModuleName, 9, "unauthorized")
+)
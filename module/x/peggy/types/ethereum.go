@@ -0,0 +1,29 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ethAddressRegex matches a 20 byte hex encoded Ethereum address, with or
+// without the leading "0x" and regardless of EIP-55 checksum casing
+var ethAddressRegex = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{40}$`)
+
+// ValidateEthAddress returns an error if the given string is not a
+// well-formed Ethereum address. It does not verify EIP-55 checksums since
+// several call sites accept addresses that have already been normalized.
+func ValidateEthAddress(address string) error {
+	if address == "" {
+		return ErrEmpty
+	}
+	if !ethAddressRegex.MatchString(address) {
+		return ErrInvalidEthAddress
+	}
+	return nil
+}
+
+// NormalizeEthAddress lowercases an Ethereum address so it can be used as a
+// reliable map/store key regardless of how the caller cased it.
+func NormalizeEthAddress(address string) string {
+	return strings.ToLower(address)
+}
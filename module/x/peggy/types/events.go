@@ -0,0 +1,31 @@
+package types
+
+// Event types and attribute keys emitted by the peggy module
+const (
+	EventTypeSendToEth     = "send_to_eth"
+	EventTypeOutgoingBatch = "outgoing_batch"
+	EventTypeValsetRequest = "valset_request"
+	EventTypeDeployERC20   = "deploy_erc20"
+
+	EventTypeSlashValsetUnsigned    = "slash_valset_unsigned"
+	EventTypeSlashBatchUnsigned     = "slash_batch_unsigned"
+	EventTypeSlashClaimLagging      = "slash_claim_lagging"
+	EventTypeSlashLogicCallUnsigned = "slash_logic_call_unsigned"
+
+	EventTypeLogicCall = "logic_call"
+
+	AttributeKeySender         = "sender"
+	AttributeKeyEthDest        = "eth_dest"
+	AttributeKeyBatchNonce     = "batch_nonce"
+	AttributeKeyValsetNonce    = "valset_nonce"
+	AttributeKeyDenom          = "denom"
+	AttributeKeyName           = "name"
+	AttributeKeySymbol         = "symbol"
+	AttributeKeyValidator      = "validator"
+	AttributeKeyInvalidationID = "invalidation_id"
+)
+
+// DefaultBatchTimeoutBlocks is how many blocks past the current height
+// RequestBatch sets as a new batch's BatchTimeout. Nothing in this module
+// re-batches a batch whose timeout has passed.
+const DefaultBatchTimeoutBlocks = 1000
@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// BankKeeper defines the expected bank keeper methods used by peggy. It is
+// satisfied by both the standalone bank.Keeper and the bank methods
+// embedded in supply.Keeper, so it can be mocked independently of either.
+type BankKeeper interface {
+	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
+}
+
+// SupplyKeeper defines the expected supply keeper methods used by peggy to
+// lock outgoing coins in the module account, mint bridged-in vouchers and
+// refund superseded logic calls. BurnCoins is listed but never called.
+type SupplyKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// StakingKeeper defines the expected staking keeper methods used by peggy
+// to build Valsets and locate validators for slashing
+type StakingKeeper interface {
+	GetLastTotalPower(ctx sdk.Context) sdk.Int
+	// Validator mirrors the staking keeper's exported.ValidatorSet method
+	// rather than the concrete GetValidator, since Go interface
+	// satisfaction requires an exact return type match and GetValidator
+	// returns the concrete staking.Validator.
+	Validator(ctx sdk.Context, addr sdk.ValAddress) stakingexported.ValidatorI
+	IterateBondedValidatorsByPower(ctx sdk.Context, fn func(index int64, validator stakingexported.ValidatorI) (stop bool))
+}
+
+// SlashingKeeper defines the expected slashing keeper methods used by peggy
+// to punish validators that fail to sign valsets, batches, or claims
+type SlashingKeeper interface {
+	Jail(ctx sdk.Context, consAddr sdk.ConsAddress)
+	Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64)
+}
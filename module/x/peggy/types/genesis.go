@@ -0,0 +1,123 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EthAddressBinding pairs a validator operator address with the Ethereum
+// address its orchestrator submitted via MsgSetEthAddress
+type EthAddressBinding struct {
+	Validator  sdk.ValAddress `json:"validator"`
+	EthAddress string         `json:"eth_address"`
+}
+
+// LastObservedEventNonce records the highest Ethereum event nonce the
+// module has fully processed for one claim type
+type LastObservedEventNonce struct {
+	ClaimType ClaimType `json:"claim_type"`
+	Nonce     uint64    `json:"nonce"`
+}
+
+// GenesisState holds the bridge state needed to halt a chain, export it to
+// JSON, and restart from it without losing in-flight batches or forcing
+// relayers to re-sign. It does not hold the ERC20<->denom registry, the
+// unbatched outgoing pool, or each validator's last claimed event nonce.
+type GenesisState struct {
+	Params                  Params                   `json:"params"`
+	EthAddresses            []EthAddressBinding      `json:"eth_addresses"`
+	Valsets                 []Valset                 `json:"valsets"`
+	ValsetConfirms          []ValsetConfirm          `json:"valset_confirms"`
+	NextValsetNonce         uint64                   `json:"next_valset_nonce"`
+	Batches                 []OutgoingTxBatch        `json:"batches"`
+	BatchConfirms           []BatchConfirm           `json:"batch_confirms"`
+	NextBatchNonce          uint64                   `json:"next_batch_nonce"`
+	LogicCalls              []OutgoingLogicCall      `json:"logic_calls"`
+	LogicCallConfirms       []LogicCallConfirm       `json:"logic_call_confirms"`
+	Attestations            []Attestation            `json:"attestations"`
+	LastObservedEventNonces []LastObservedEventNonce `json:"last_observed_event_nonces"`
+}
+
+// DefaultGenesisState returns the peggy module's default genesis state: no
+// bridge activity has ever been observed
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params:          DefaultParams(),
+		NextValsetNonce: 1,
+		NextBatchNonce:  1,
+		LastObservedEventNonces: []LastObservedEventNonce{
+			{ClaimType: ClaimTypeEthereumBridgeDeposit, Nonce: 0},
+			{ClaimType: ClaimTypeEthereumBridgeWithdrawalBatch, Nonce: 0},
+			{ClaimType: ClaimTypeEthereumBridgeMultiSigUpdate, Nonce: 0},
+		},
+	}
+}
+
+// ValidateGenesis sanity-checks a GenesisState before it is used to
+// initialize a chain. It rejects duplicate eth-address bindings (two
+// validators can never share one Ethereum signer) and confirmations that
+// reference a valset or batch nonce absent from the genesis state.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.ValidateBasic(); err != nil {
+		return fmt.Errorf("params: %w", err)
+	}
+
+	seenEthAddrs := make(map[string]string, len(data.EthAddresses))
+	for _, b := range data.EthAddresses {
+		if b.Validator.Empty() {
+			return fmt.Errorf("eth address binding: empty validator")
+		}
+		if err := ValidateEthAddress(b.EthAddress); err != nil {
+			return fmt.Errorf("eth address binding for %s: %w", b.Validator, err)
+		}
+		norm := NormalizeEthAddress(b.EthAddress)
+		if other, ok := seenEthAddrs[norm]; ok {
+			return fmt.Errorf("eth address %s bound to both %s and %s", b.EthAddress, other, b.Validator)
+		}
+		seenEthAddrs[norm] = b.Validator.String()
+	}
+
+	knownValsets := make(map[uint64]bool, len(data.Valsets))
+	for _, v := range data.Valsets {
+		knownValsets[v.Nonce] = true
+	}
+	for _, c := range data.ValsetConfirms {
+		if !knownValsets[c.Nonce] {
+			return fmt.Errorf("%w: valset confirm for nonce %d by %s references no genesis valset",
+				ErrOrphanConfirmation, c.Nonce, c.Orchestrator)
+		}
+	}
+
+	knownBatches := make(map[string]bool, len(data.Batches))
+	for _, b := range data.Batches {
+		knownBatches[batchKey(b.TokenContract, b.BatchNonce)] = true
+	}
+	for _, c := range data.BatchConfirms {
+		if !knownBatches[batchKey(c.TokenContract, c.Nonce)] {
+			return fmt.Errorf("%w: batch confirm for %s/%d by %s references no genesis batch",
+				ErrOrphanConfirmation, c.TokenContract, c.Nonce, c.Orchestrator)
+		}
+	}
+
+	knownLogicCalls := make(map[string]bool, len(data.LogicCalls))
+	for _, l := range data.LogicCalls {
+		knownLogicCalls[logicCallKey(l.InvalidationID, l.InvalidationNonce)] = true
+	}
+	for _, c := range data.LogicCallConfirms {
+		if !knownLogicCalls[logicCallKey(c.InvalidationID, c.InvalidationNonce)] {
+			return fmt.Errorf("%w: logic call confirm for %x/%d by %s references no genesis logic call",
+				ErrOrphanConfirmation, c.InvalidationID, c.InvalidationNonce, c.Orchestrator)
+		}
+	}
+
+	return nil
+}
+
+func batchKey(tokenContract string, nonce uint64) string {
+	return fmt.Sprintf("%s/%d", NormalizeEthAddress(tokenContract), nonce)
+}
+
+func logicCallKey(invalidationID []byte, invalidationNonce uint64) string {
+	return fmt.Sprintf("%x/%d", invalidationID, invalidationNonce)
+}
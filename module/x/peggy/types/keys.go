@@ -0,0 +1,188 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the peggy module
+	ModuleName = "peggy"
+
+	// StoreKey is the store key string for peggy
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for peggy
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for peggy
+	QuerierRoute = ModuleName
+)
+
+// Key prefixes for the peggy store. Each entry is a single byte so that
+// iteration over a prefix never spills into a neighboring collection.
+var (
+	// EthAddressKey indexes the orchestrator-submitted Ethereum address by
+	// validator operator address
+	EthAddressKey = []byte{0x1}
+
+	// ValsetRequestKey indexes historical Valsets by nonce
+	ValsetRequestKey = []byte{0x2}
+
+	// ValsetConfirmKey indexes a validator's MsgValsetConfirm by
+	// (valset nonce, validator)
+	ValsetConfirmKey = []byte{0x3}
+
+	// OutgoingTXPoolKey indexes unbatched MsgSendToEth transactions by id
+	OutgoingTXPoolKey = []byte{0x4}
+
+	// OutgoingTXBatchKey indexes OutgoingTxBatches by (token contract, nonce)
+	OutgoingTXBatchKey = []byte{0x5}
+
+	// OutgoingTXBatchConfirmKey indexes a validator's MsgConfirmBatch by
+	// (token contract, nonce, validator)
+	OutgoingTXBatchConfirmKey = []byte{0x6}
+
+	// OracleAttestationKey indexes Attestations by (claim nonce, claim details hash)
+	OracleAttestationKey = []byte{0x7}
+
+	// LastEventNonceByValidatorKey indexes the last Ethereum event nonce
+	// observed by each validator's orchestrator
+	LastEventNonceByValidatorKey = []byte{0x8}
+
+	// LastObservedEventNonceKey stores the last Ethereum event nonce the
+	// module itself has fully processed
+	LastObservedEventNonceKey = []byte{0x9}
+
+	// DenomToERC20Key indexes the registered ERC20 contract address for a
+	// Cosmos denom
+	DenomToERC20Key = []byte{0xA}
+
+	// ERC20ToDenomKey indexes the registered Cosmos denom for a
+	// (lowercased) ERC20 contract address
+	ERC20ToDenomKey = []byte{0xB}
+
+	// LogicCallKey indexes OutgoingLogicCalls by (invalidation id,
+	// invalidation nonce)
+	LogicCallKey = []byte{0xC}
+
+	// LogicCallConfirmKey indexes a validator's MsgConfirmLogicCall by
+	// (invalidation id, invalidation nonce, validator)
+	LogicCallConfirmKey = []byte{0xD}
+
+	// LogicCallLatestNonceKey indexes the highest InvalidationNonce ever
+	// stored for a given InvalidationID; it is kept after that call is
+	// deleted as executed
+	LogicCallLatestNonceKey = []byte{0xE}
+
+	// SlashedValsetKey marks that a validator has already been slashed for
+	// failing to sign a given Valset, by (nonce, validator), so the
+	// EndBlocker penalty is applied once per unsigned Valset rather than
+	// every block it remains unsigned
+	SlashedValsetKey = []byte{0xF}
+
+	// SlashedBatchKey marks that a validator has already been slashed for
+	// failing to sign a given OutgoingTxBatch, by (token contract, nonce,
+	// validator)
+	SlashedBatchKey = []byte{0x10}
+
+	// SlashedLogicCallKey marks that a validator has already been slashed
+	// for failing to sign a given OutgoingLogicCall, by (invalidation id,
+	// invalidation nonce, validator)
+	SlashedLogicCallKey = []byte{0x11}
+
+	// NextValsetNonceKey stores the next nonce CurrentValset will assign,
+	// under its own top-level prefix so it is never picked up by
+	// IterateValsets' scan over ValsetRequestKey (see LogicCallLatestNonceKey
+	// for the same pattern)
+	NextValsetNonceKey = []byte{0x12}
+
+	// NextBatchNonceKey stores the next nonce BuildOutgoingTxBatch will
+	// assign, under its own top-level prefix so it is never picked up by
+	// IterateOutgoingTxBatches' scan over OutgoingTXBatchKey
+	NextBatchNonceKey = []byte{0x13}
+)
+
+// GetEthAddressKey returns the key for an orchestrator's Ethereum address
+func GetEthAddressKey(validator sdk.ValAddress) []byte {
+	return append(EthAddressKey, validator.Bytes()...)
+}
+
+// GetValsetKey returns the key for a Valset by nonce
+func GetValsetKey(nonce uint64) []byte {
+	return append(ValsetRequestKey, sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// GetValsetConfirmKey returns the key for a validator's valset confirmation
+func GetValsetConfirmKey(nonce uint64, validator sdk.AccAddress) []byte {
+	return append(ValsetConfirmKey, append(sdk.Uint64ToBigEndian(nonce), validator.Bytes()...)...)
+}
+
+// GetOutgoingTxBatchKey returns the key for an OutgoingTxBatch
+func GetOutgoingTxBatchKey(tokenContract string, nonce uint64) []byte {
+	return append(OutgoingTXBatchKey, append([]byte(tokenContract), sdk.Uint64ToBigEndian(nonce)...)...)
+}
+
+// GetBatchConfirmKey returns the key for a validator's batch confirmation
+func GetBatchConfirmKey(tokenContract string, nonce uint64, validator sdk.AccAddress) []byte {
+	prefix := append([]byte(tokenContract), sdk.Uint64ToBigEndian(nonce)...)
+	return append(OutgoingTXBatchConfirmKey, append(prefix, validator.Bytes()...)...)
+}
+
+// GetAttestationKey returns the key for an Attestation by event nonce and
+// the hash of its claim details, so independently observed claims that
+// happen to collide on nonce alone never overwrite one another
+func GetAttestationKey(eventNonce uint64, claimHash []byte) []byte {
+	return append(OracleAttestationKey, append(sdk.Uint64ToBigEndian(eventNonce), claimHash...)...)
+}
+
+// GetLastEventNonceByValidatorKey returns the key for a validator's last
+// observed Ethereum event nonce
+func GetLastEventNonceByValidatorKey(validator sdk.ValAddress) []byte {
+	return append(LastEventNonceByValidatorKey, validator.Bytes()...)
+}
+
+// GetDenomToERC20Key returns the key for a denom's registered ERC20
+// contract address
+func GetDenomToERC20Key(denom string) []byte {
+	return append(DenomToERC20Key, []byte(denom)...)
+}
+
+// GetERC20ToDenomKey returns the key for a (lowercased) ERC20 contract
+// address's registered denom
+func GetERC20ToDenomKey(erc20Address string) []byte {
+	return append(ERC20ToDenomKey, []byte(NormalizeEthAddress(erc20Address))...)
+}
+
+// GetLogicCallKey returns the key for an OutgoingLogicCall by
+// (invalidation id, invalidation nonce)
+func GetLogicCallKey(invalidationID []byte, invalidationNonce uint64) []byte {
+	prefix := append(append([]byte{}, invalidationID...), sdk.Uint64ToBigEndian(invalidationNonce)...)
+	return append(LogicCallKey, prefix...)
+}
+
+// GetLogicCallConfirmKey returns the key for a validator's logic call
+// confirmation
+func GetLogicCallConfirmKey(invalidationID []byte, invalidationNonce uint64, validator sdk.AccAddress) []byte {
+	prefix := append(append([]byte{}, invalidationID...), sdk.Uint64ToBigEndian(invalidationNonce)...)
+	return append(LogicCallConfirmKey, append(prefix, validator.Bytes()...)...)
+}
+
+// GetSlashedValsetKey returns the key marking that a validator has already
+// been slashed for failing to sign the given Valset
+func GetSlashedValsetKey(nonce uint64, validator sdk.ValAddress) []byte {
+	return append(SlashedValsetKey, append(sdk.Uint64ToBigEndian(nonce), validator.Bytes()...)...)
+}
+
+// GetSlashedBatchKey returns the key marking that a validator has already
+// been slashed for failing to sign the given OutgoingTxBatch
+func GetSlashedBatchKey(tokenContract string, nonce uint64, validator sdk.ValAddress) []byte {
+	prefix := append([]byte(tokenContract), sdk.Uint64ToBigEndian(nonce)...)
+	return append(SlashedBatchKey, append(prefix, validator.Bytes()...)...)
+}
+
+// GetSlashedLogicCallKey returns the key marking that a validator has
+// already been slashed for failing to sign the given OutgoingLogicCall
+func GetSlashedLogicCallKey(invalidationID []byte, invalidationNonce uint64, validator sdk.ValAddress) []byte {
+	prefix := append(append([]byte{}, invalidationID...), sdk.Uint64ToBigEndian(invalidationNonce)...)
+	return append(SlashedLogicCallKey, append(prefix, validator.Bytes()...)...)
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OutgoingLogicCall is an arbitrary contract invocation batched for
+// execution on Ethereum by the bridge multisig, alongside the ERC20
+// transfer batches in batch.go. It is keyed in the store by
+// (InvalidationID, InvalidationNonce): submitting a new call with a higher
+// InvalidationNonce for the same InvalidationID supersedes (cancels) any
+// earlier, unexecuted call sharing that ID.
+type OutgoingLogicCall struct {
+	Requester            sdk.AccAddress `json:"requester"`
+	Transfers            []sdk.Coin     `json:"transfers"`
+	Fees                 []sdk.Coin     `json:"fees"`
+	LogicContractAddress string         `json:"logic_contract_address"`
+	Payload              []byte         `json:"payload"`
+	Timeout              uint64         `json:"timeout"`
+	InvalidationID       []byte         `json:"invalidation_id"`
+	InvalidationNonce    uint64         `json:"invalidation_nonce"`
+	Block                uint64         `json:"block"`
+	// Members is the bridge validator set bonded at the time this logic
+	// call was created; see OutgoingTxBatch.Members for why the slashing
+	// pass needs this snapshot instead of the currently bonded set
+	Members []BridgeValidator `json:"members"`
+}
+
+// LogicCallConfirm is a validator's Ethereum signature over an
+// OutgoingLogicCall's checkpoint, keyed by (invalidation id, invalidation
+// nonce, orchestrator) in the store, the same confirmation scheme used for
+// OutgoingTxBatch
+type LogicCallConfirm struct {
+	InvalidationID    []byte         `json:"invalidation_id"`
+	InvalidationNonce uint64         `json:"invalidation_nonce"`
+	Orchestrator      sdk.AccAddress `json:"orchestrator"`
+	EthSigner         string         `json:"eth_signer"`
+	Signature         string         `json:"signature"`
+}
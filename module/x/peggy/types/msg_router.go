@@ -0,0 +1,121 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgRouter groups one method per peggy Msg kind onto a single interface,
+// implemented by keeper.msgRouter and dispatched to from the legacy amino
+// Handler in handler.go. It exists to keep that dispatch organized.
+//
+// STATUS (kishidas/peggy#chunk0-2): NOT IMPLEMENTED. The request behind
+// this module's migration asked for a protoc/buf-generated Stargate
+// MsgServer/QueryServer — generated proto Go types, a keeper.msgServer
+// implementing types.MsgServer, a QueryServer, and a RegisterServices hook
+// wired into the module manager. None of that exists here: MsgRouter is a
+// hand-written Go interface dispatched from the legacy amino Handler, not
+// a generated gRPC service, and there is no RegisterServices hook anywhere
+// in module.go. There is no protoc/buf toolchain or generated-code
+// dependency available in this tree to produce the real thing, so the
+// request could not be completed as filed. It is left open rather than
+// closed: any client or tooling expecting a real Stargate gRPC service
+// (grpcurl, gRPC-Gateway, a proto-generated MsgClient) will not work
+// against this module, and that gap should be picked back up once a
+// protoc/buf toolchain is available here, not reinterpreted as settled
+// scope.
+type MsgRouter interface {
+	SendToEth(context.Context, *MsgSendToEth) (*MsgSendToEthResponse, error)
+	RequestBatch(context.Context, *MsgRequestBatch) (*MsgRequestBatchResponse, error)
+	ConfirmBatch(context.Context, *MsgConfirmBatch) (*MsgConfirmBatchResponse, error)
+	SetEthAddress(context.Context, *MsgSetEthAddress) (*MsgSetEthAddressResponse, error)
+	ValsetConfirm(context.Context, *MsgValsetConfirm) (*MsgValsetConfirmResponse, error)
+	ValsetRequest(context.Context, *MsgValsetRequest) (*MsgValsetRequestResponse, error)
+	DepositClaim(context.Context, *MsgDepositClaim) (*MsgDepositClaimResponse, error)
+	WithdrawClaim(context.Context, *MsgWithdrawClaim) (*MsgWithdrawClaimResponse, error)
+	ValsetUpdateClaim(context.Context, *MsgValsetUpdateClaim) (*MsgValsetUpdateClaimResponse, error)
+	RegisterERC20(context.Context, *MsgRegisterERC20) (*MsgRegisterERC20Response, error)
+	DeployERC20(context.Context, *MsgDeployERC20) (*MsgDeployERC20Response, error)
+	ERC20DeployedClaim(context.Context, *MsgERC20DeployedClaim) (*MsgERC20DeployedClaimResponse, error)
+	SubmitLogicCall(context.Context, *MsgSubmitLogicCall) (*MsgSubmitLogicCallResponse, error)
+	ConfirmLogicCall(context.Context, *MsgConfirmLogicCall) (*MsgConfirmLogicCallResponse, error)
+	LogicCallExecutedClaim(context.Context, *MsgLogicCallExecutedClaim) (*MsgLogicCallExecutedClaimResponse, error)
+}
+
+// Response types for each MsgRouter method. Most carry no data: success is
+// the absence of an error.
+type (
+	MsgSendToEthResponse              struct{}
+	MsgRequestBatchResponse           struct{}
+	MsgConfirmBatchResponse           struct{}
+	MsgSetEthAddressResponse          struct{}
+	MsgValsetConfirmResponse          struct{}
+	MsgDepositClaimResponse           struct{}
+	MsgWithdrawClaimResponse          struct{}
+	MsgValsetUpdateClaimResponse      struct{}
+	MsgRegisterERC20Response          struct{}
+	MsgDeployERC20Response            struct{}
+	MsgERC20DeployedClaimResponse     struct{}
+	MsgSubmitLogicCallResponse        struct{}
+	MsgConfirmLogicCallResponse       struct{}
+	MsgLogicCallExecutedClaimResponse struct{}
+)
+
+// MsgValsetRequestResponse returns the nonce of the Valset that was created,
+// so a CLI/relayer caller doesn't need a follow-up query.
+type MsgValsetRequestResponse struct {
+	Nonce uint64
+}
+
+// MsgDepositClaim, MsgWithdrawClaim and MsgValsetUpdateClaim are the typed
+// replacements for EthereumBridgeDepositClaim/WithdrawalBatchClaim/
+// MultiSigUpdateClaim carried over MsgCreateEthereumClaims: each is both a
+// standalone sdk.Msg and an EthereumClaim.
+
+var (
+	_ EthereumClaim = MsgDepositClaim{}
+	_ EthereumClaim = MsgWithdrawClaim{}
+	_ EthereumClaim = MsgValsetUpdateClaim{}
+)
+
+func (m MsgDepositClaim) GetType() ClaimType    { return ClaimTypeEthereumBridgeDeposit }
+func (m MsgDepositClaim) GetEventNonce() uint64 { return m.EventNonce }
+
+// ClaimHash hashes only the observed-event fields, excluding Orchestrator:
+// every validator attesting to the same deposit submits its own
+// Orchestrator, so folding it into the hash would put each validator's
+// claim for the same event into a different Attestation bucket and votes
+// could never cross the voting-power threshold.
+func (m MsgDepositClaim) ClaimHash() []byte {
+	return ModuleCdc.MustMarshalBinaryBare(struct {
+		EventNonce     uint64
+		TokenContract  string
+		Amount         sdk.Int
+		EthereumSender string
+		CosmosReceiver sdk.AccAddress
+	}{m.EventNonce, m.TokenContract, m.Amount, m.EthereumSender, m.CosmosReceiver})
+}
+
+func (m MsgWithdrawClaim) GetType() ClaimType    { return ClaimTypeEthereumBridgeWithdrawalBatch }
+func (m MsgWithdrawClaim) GetEventNonce() uint64 { return m.EventNonce }
+
+// ClaimHash hashes only the observed-event fields; see MsgDepositClaim.
+func (m MsgWithdrawClaim) ClaimHash() []byte {
+	return ModuleCdc.MustMarshalBinaryBare(struct {
+		EventNonce    uint64
+		BatchNonce    uint64
+		TokenContract string
+	}{m.EventNonce, m.BatchNonce, m.TokenContract})
+}
+
+func (m MsgValsetUpdateClaim) GetType() ClaimType    { return ClaimTypeEthereumBridgeMultiSigUpdate }
+func (m MsgValsetUpdateClaim) GetEventNonce() uint64 { return m.EventNonce }
+
+// ClaimHash hashes only the observed-event fields; see MsgDepositClaim.
+func (m MsgValsetUpdateClaim) ClaimHash() []byte {
+	return ModuleCdc.MustMarshalBinaryBare(struct {
+		EventNonce  uint64
+		ValsetNonce uint64
+	}{m.EventNonce, m.ValsetNonce})
+}
@@ -0,0 +1,143 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// message types for the ERC20<->denom registry
+const (
+	TypeMsgRegisterERC20      = "register_erc20"
+	TypeMsgDeployERC20        = "deploy_erc20"
+	TypeMsgERC20DeployedClaim = "erc20_deployed_claim"
+)
+
+var (
+	_ sdk.Msg        = MsgRegisterERC20{}
+	_ sdk.Msg        = MsgDeployERC20{}
+	_ sdk.Msg        = MsgERC20DeployedClaim{}
+	_ EthereumClaim  = MsgERC20DeployedClaim{}
+)
+
+// MsgRegisterERC20 registers an already-deployed ERC20 contract as the
+// backing asset for a Cosmos denom. It is governance-gated: Authority must
+// be the gov module account
+type MsgRegisterERC20 struct {
+	Authority    sdk.AccAddress `json:"authority"`
+	Denom        string         `json:"denom"`
+	ERC20Address string         `json:"erc20_address"`
+}
+
+func (msg MsgRegisterERC20) Route() string { return RouterKey }
+func (msg MsgRegisterERC20) Type() string  { return TypeMsgRegisterERC20 }
+
+func (msg MsgRegisterERC20) ValidateBasic() error {
+	if msg.Authority.Empty() {
+		return sdkerrors.Wrap(ErrEmpty, "authority")
+	}
+	if msg.Denom == "" {
+		return sdkerrors.Wrap(ErrEmpty, "denom")
+	}
+	if err := ValidateEthAddress(msg.ERC20Address); err != nil {
+		return sdkerrors.Wrap(err, "erc20 address")
+	}
+	return nil
+}
+
+func (msg MsgRegisterERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRegisterERC20) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+// MsgDeployERC20 requests that the orchestrator set deploy a brand new
+// ERC20 contract on Ethereum for a denom that doesn't have one yet,
+// completed by a later MsgERC20DeployedClaim once it is observed deployed
+type MsgDeployERC20 struct {
+	Requester sdk.AccAddress `json:"requester"`
+	Denom     string         `json:"denom"`
+	Name      string         `json:"name"`
+	Symbol    string         `json:"symbol"`
+	Decimals  uint32         `json:"decimals"`
+}
+
+func (msg MsgDeployERC20) Route() string { return RouterKey }
+func (msg MsgDeployERC20) Type() string  { return TypeMsgDeployERC20 }
+
+func (msg MsgDeployERC20) ValidateBasic() error {
+	if msg.Requester.Empty() {
+		return sdkerrors.Wrap(ErrEmpty, "requester")
+	}
+	if msg.Denom == "" {
+		return sdkerrors.Wrap(ErrEmpty, "denom")
+	}
+	if msg.Name == "" || msg.Symbol == "" {
+		return sdkerrors.Wrap(ErrEmpty, "name/symbol")
+	}
+	return nil
+}
+
+func (msg MsgDeployERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgDeployERC20) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Requester}
+}
+
+// MsgERC20DeployedClaim attests that a denom's ERC20 contract was deployed
+// on Ethereum, completing the round trip started by MsgDeployERC20 and
+// registering the resulting address in the ERC20<->denom table
+type MsgERC20DeployedClaim struct {
+	EventNonce   uint64         `json:"event_nonce"`
+	Denom        string         `json:"denom"`
+	ERC20Address string         `json:"erc20_address"`
+	Name         string         `json:"name"`
+	Symbol       string         `json:"symbol"`
+	Decimals     uint32         `json:"decimals"`
+	Orchestrator sdk.AccAddress `json:"orchestrator"`
+}
+
+func (msg MsgERC20DeployedClaim) Route() string { return RouterKey }
+func (msg MsgERC20DeployedClaim) Type() string  { return TypeMsgERC20DeployedClaim }
+
+func (msg MsgERC20DeployedClaim) ValidateBasic() error {
+	if msg.Orchestrator.Empty() {
+		return sdkerrors.Wrap(ErrEmpty, "orchestrator")
+	}
+	if msg.Denom == "" {
+		return sdkerrors.Wrap(ErrEmpty, "denom")
+	}
+	if err := ValidateEthAddress(msg.ERC20Address); err != nil {
+		return sdkerrors.Wrap(err, "erc20 address")
+	}
+	return nil
+}
+
+func (msg MsgERC20DeployedClaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgERC20DeployedClaim) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Orchestrator}
+}
+
+const ClaimTypeERC20Deployed ClaimType = "erc20_deployed"
+
+func (msg MsgERC20DeployedClaim) GetType() ClaimType    { return ClaimTypeERC20Deployed }
+func (msg MsgERC20DeployedClaim) GetEventNonce() uint64 { return msg.EventNonce }
+
+// ClaimHash hashes only the observed-event fields, excluding Orchestrator;
+// see MsgDepositClaim.ClaimHash in msg_router.go for why.
+func (msg MsgERC20DeployedClaim) ClaimHash() []byte {
+	return ModuleCdc.MustMarshalBinaryBare(struct {
+		EventNonce   uint64
+		Denom        string
+		ERC20Address string
+		Name         string
+		Symbol       string
+		Decimals     uint32
+	}{msg.EventNonce, msg.Denom, msg.ERC20Address, msg.Name, msg.Symbol, msg.Decimals})
+}
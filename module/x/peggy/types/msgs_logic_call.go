@@ -0,0 +1,157 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// message types for arbitrary logic-call batches
+const (
+	TypeMsgSubmitLogicCall        = "submit_logic_call"
+	TypeMsgConfirmLogicCall       = "confirm_logic_call"
+	TypeMsgLogicCallExecutedClaim = "logic_call_executed_claim"
+)
+
+var (
+	_ sdk.Msg       = MsgSubmitLogicCall{}
+	_ sdk.Msg       = MsgConfirmLogicCall{}
+	_ sdk.Msg       = MsgLogicCallExecutedClaim{}
+	_ EthereumClaim = MsgLogicCallExecutedClaim{}
+)
+
+// MsgSubmitLogicCall requests that the chain schedule an arbitrary contract
+// invocation for the bridge multisig to execute on Ethereum, optionally
+// moving ERC20-backed coins to the target contract as part of the call
+type MsgSubmitLogicCall struct {
+	Requester            sdk.AccAddress `json:"requester"`
+	Transfers            []sdk.Coin     `json:"transfers"`
+	Fees                 []sdk.Coin     `json:"fees"`
+	LogicContractAddress string         `json:"logic_contract_address"`
+	Payload              []byte         `json:"payload"`
+	Timeout              uint64         `json:"timeout"`
+	InvalidationID       []byte         `json:"invalidation_id"`
+	InvalidationNonce    uint64         `json:"invalidation_nonce"`
+}
+
+func (msg MsgSubmitLogicCall) Route() string { return RouterKey }
+func (msg MsgSubmitLogicCall) Type() string  { return TypeMsgSubmitLogicCall }
+
+func (msg MsgSubmitLogicCall) ValidateBasic() error {
+	if msg.Requester.Empty() {
+		return sdkerrors.Wrap(ErrEmpty, "requester")
+	}
+	if err := ValidateEthAddress(msg.LogicContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "logic contract address")
+	}
+	if len(msg.InvalidationID) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "invalidation id")
+	}
+	if msg.InvalidationNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "invalidation nonce")
+	}
+	if msg.Timeout == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "timeout")
+	}
+	for _, t := range msg.Transfers {
+		if !t.IsValid() {
+			return sdkerrors.Wrap(ErrInvalid, "transfers")
+		}
+	}
+	for _, f := range msg.Fees {
+		if !f.IsValid() {
+			return sdkerrors.Wrap(ErrInvalid, "fees")
+		}
+	}
+	return nil
+}
+
+func (msg MsgSubmitLogicCall) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSubmitLogicCall) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Requester}
+}
+
+// MsgConfirmLogicCall submits a validator's Ethereum signature over an
+// OutgoingLogicCall's checkpoint, the same confirmation scheme used for
+// MsgConfirmBatch
+type MsgConfirmLogicCall struct {
+	InvalidationID    []byte         `json:"invalidation_id"`
+	InvalidationNonce uint64         `json:"invalidation_nonce"`
+	Orchestrator      sdk.AccAddress `json:"orchestrator"`
+	EthSigner         string         `json:"eth_signer"`
+	Signature         string         `json:"signature"`
+}
+
+func (msg MsgConfirmLogicCall) Route() string { return RouterKey }
+func (msg MsgConfirmLogicCall) Type() string  { return TypeMsgConfirmLogicCall }
+
+func (msg MsgConfirmLogicCall) ValidateBasic() error {
+	if msg.Orchestrator.Empty() {
+		return sdkerrors.Wrap(ErrEmpty, "orchestrator")
+	}
+	if err := ValidateEthAddress(msg.EthSigner); err != nil {
+		return sdkerrors.Wrap(err, "eth signer")
+	}
+	if len(msg.InvalidationID) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "invalidation id")
+	}
+	if msg.Signature == "" {
+		return sdkerrors.Wrap(ErrEmpty, "signature")
+	}
+	return nil
+}
+
+func (msg MsgConfirmLogicCall) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgConfirmLogicCall) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Orchestrator}
+}
+
+// MsgLogicCallExecutedClaim attests that an OutgoingLogicCall was executed
+// on Ethereum, completing the round trip started by MsgSubmitLogicCall
+type MsgLogicCallExecutedClaim struct {
+	EventNonce        uint64         `json:"event_nonce"`
+	InvalidationID    []byte         `json:"invalidation_id"`
+	InvalidationNonce uint64         `json:"invalidation_nonce"`
+	Orchestrator      sdk.AccAddress `json:"orchestrator"`
+}
+
+func (msg MsgLogicCallExecutedClaim) Route() string { return RouterKey }
+func (msg MsgLogicCallExecutedClaim) Type() string  { return TypeMsgLogicCallExecutedClaim }
+
+func (msg MsgLogicCallExecutedClaim) ValidateBasic() error {
+	if msg.Orchestrator.Empty() {
+		return sdkerrors.Wrap(ErrEmpty, "orchestrator")
+	}
+	if len(msg.InvalidationID) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "invalidation id")
+	}
+	return nil
+}
+
+func (msg MsgLogicCallExecutedClaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgLogicCallExecutedClaim) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Orchestrator}
+}
+
+const ClaimTypeLogicCallExecuted ClaimType = "logic_call_executed"
+
+func (msg MsgLogicCallExecutedClaim) GetType() ClaimType    { return ClaimTypeLogicCallExecuted }
+func (msg MsgLogicCallExecutedClaim) GetEventNonce() uint64 { return msg.EventNonce }
+
+// ClaimHash hashes only the observed-event fields, excluding Orchestrator;
+// see MsgDepositClaim.ClaimHash in msg_router.go for why.
+func (msg MsgLogicCallExecutedClaim) ClaimHash() []byte {
+	return ModuleCdc.MustMarshalBinaryBare(struct {
+		EventNonce        uint64
+		InvalidationID    []byte
+		InvalidationNonce uint64
+	}{msg.EventNonce, msg.InvalidationID, msg.InvalidationNonce})
+}
@@ -0,0 +1,142 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	ParamStoreKeyBridgeContractAddress = []byte("BridgeContractAddress")
+	ParamStoreKeyBridgeChainID         = []byte("BridgeChainID")
+
+	ParamStoreKeySignedValsetsWindow    = []byte("SignedValsetsWindow")
+	ParamStoreKeySignedBatchesWindow    = []byte("SignedBatchesWindow")
+	ParamStoreKeySignedClaimsWindow     = []byte("SignedClaimsWindow")
+	ParamStoreKeySignedLogicCallsWindow = []byte("SignedLogicCallsWindow")
+	ParamStoreKeySlashFractionValset    = []byte("SlashFractionValset")
+	ParamStoreKeySlashFractionBatch     = []byte("SlashFractionBatch")
+	ParamStoreKeySlashFractionClaim     = []byte("SlashFractionClaim")
+	ParamStoreKeySlashFractionLogicCall = []byte("SlashFractionLogicCall")
+)
+
+// ParamKeyTable returns the peggy module's parameter key table, for
+// registration with the params module's subspace
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params holds the bridge-wide parameters governed by on-chain governance
+type Params struct {
+	BridgeContractAddress string `json:"bridge_contract_address"`
+	BridgeChainID         uint64 `json:"bridge_chain_id"`
+
+	// SignedValsetsWindow is how many blocks a Valset is given to collect
+	// MsgValsetConfirms before the EndBlocker slashes validators that
+	// never signed it
+	SignedValsetsWindow uint64 `json:"signed_valsets_window"`
+	// SignedBatchesWindow is the batch equivalent of SignedValsetsWindow
+	SignedBatchesWindow uint64 `json:"signed_batches_window"`
+	// SignedClaimsWindow is how far a validator's last observed event
+	// nonce may lag the module's LastObservedEventNonce before the
+	// EndBlocker slashes it for not observing Ethereum events
+	SignedClaimsWindow uint64 `json:"signed_claims_window"`
+	// SignedLogicCallsWindow is the logic-call equivalent of
+	// SignedBatchesWindow
+	SignedLogicCallsWindow uint64 `json:"signed_logic_calls_window"`
+
+	// SlashFractionValset is the portion of a validator's stake slashed
+	// for failing to sign a Valset within SignedValsetsWindow
+	SlashFractionValset sdk.Dec `json:"slash_fraction_valset"`
+	// SlashFractionBatch is the batch equivalent of SlashFractionValset
+	SlashFractionBatch sdk.Dec `json:"slash_fraction_batch"`
+	// SlashFractionClaim is the portion of a validator's stake slashed for
+	// falling behind on Ethereum event attestation
+	SlashFractionClaim sdk.Dec `json:"slash_fraction_claim"`
+	// SlashFractionLogicCall is the logic-call equivalent of
+	// SlashFractionBatch
+	SlashFractionLogicCall sdk.Dec `json:"slash_fraction_logic_call"`
+}
+
+// DefaultParams returns peggy module default parameters
+func DefaultParams() Params {
+	return Params{
+		BridgeContractAddress:  "",
+		BridgeChainID:          1,
+		SignedValsetsWindow:    10000,
+		SignedBatchesWindow:    10000,
+		SignedClaimsWindow:     10000,
+		SignedLogicCallsWindow: 10000,
+		SlashFractionValset:    sdk.NewDecWithPrec(1, 3), // 0.1%
+		SlashFractionBatch:     sdk.NewDecWithPrec(1, 3),
+		SlashFractionClaim:     sdk.NewDecWithPrec(1, 3),
+		SlashFractionLogicCall: sdk.NewDecWithPrec(1, 3),
+	}
+}
+
+// ParamSetPairs implements the params.ParamSet interface
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyBridgeContractAddress, &p.BridgeContractAddress, validateBridgeContractAddress),
+		paramtypes.NewParamSetPair(ParamStoreKeyBridgeChainID, &p.BridgeChainID, validateBridgeChainID),
+		paramtypes.NewParamSetPair(ParamStoreKeySignedValsetsWindow, &p.SignedValsetsWindow, validatePositiveWindow),
+		paramtypes.NewParamSetPair(ParamStoreKeySignedBatchesWindow, &p.SignedBatchesWindow, validatePositiveWindow),
+		paramtypes.NewParamSetPair(ParamStoreKeySignedClaimsWindow, &p.SignedClaimsWindow, validatePositiveWindow),
+		paramtypes.NewParamSetPair(ParamStoreKeySignedLogicCallsWindow, &p.SignedLogicCallsWindow, validatePositiveWindow),
+		paramtypes.NewParamSetPair(ParamStoreKeySlashFractionValset, &p.SlashFractionValset, validateSlashFraction),
+		paramtypes.NewParamSetPair(ParamStoreKeySlashFractionBatch, &p.SlashFractionBatch, validateSlashFraction),
+		paramtypes.NewParamSetPair(ParamStoreKeySlashFractionClaim, &p.SlashFractionClaim, validateSlashFraction),
+		paramtypes.NewParamSetPair(ParamStoreKeySlashFractionLogicCall, &p.SlashFractionLogicCall, validateSlashFraction),
+	}
+}
+
+func validatePositiveWindow(i interface{}) error {
+	window, ok := i.(uint64)
+	if !ok {
+		return ErrInvalid
+	}
+	if window == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "window must be positive")
+	}
+	return nil
+}
+
+func validateSlashFraction(i interface{}) error {
+	fraction, ok := i.(sdk.Dec)
+	if !ok {
+		return ErrInvalid
+	}
+	if fraction.IsNegative() || fraction.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalid, "slash fraction must be between 0 and 1")
+	}
+	return nil
+}
+
+func validateBridgeContractAddress(i interface{}) error {
+	addr, ok := i.(string)
+	if !ok {
+		return ErrInvalid
+	}
+	if addr == "" {
+		return nil
+	}
+	return ValidateEthAddress(addr)
+}
+
+func validateBridgeChainID(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return ErrInvalid
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation of the param set
+func (p Params) ValidateBasic() error {
+	if p.BridgeContractAddress != "" {
+		if err := ValidateEthAddress(p.BridgeContractAddress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package types
+
+import "context"
+
+// QueryRouter groups one method per peggy query onto a single interface,
+// implemented by keeper.Querier. The legacy amino query router in
+// keeper/querier.go dispatches only CurrentValset and PendingBatches; the
+// other methods have no query route and are reachable only by calling
+// keeper.Querier directly. See MsgRouter's doc comment in msg_router.go for
+// why this module has no gRPC Query service either.
+type QueryRouter interface {
+	CurrentValset(context.Context, *QueryCurrentValsetRequest) (*QueryCurrentValsetResponse, error)
+	PendingBatches(context.Context, *QueryPendingBatchesRequest) (*QueryPendingBatchesResponse, error)
+	BatchConfirms(context.Context, *QueryBatchConfirmsRequest) (*QueryBatchConfirmsResponse, error)
+	ValsetConfirms(context.Context, *QueryValsetConfirmsRequest) (*QueryValsetConfirmsResponse, error)
+	PendingSendToEth(context.Context, *QueryPendingSendToEthRequest) (*QueryPendingSendToEthResponse, error)
+	DenomToERC20(context.Context, *QueryDenomToERC20Request) (*QueryDenomToERC20Response, error)
+	ERC20ToDenom(context.Context, *QueryERC20ToDenomRequest) (*QueryERC20ToDenomResponse, error)
+	PendingLogicCalls(context.Context, *QueryPendingLogicCallsRequest) (*QueryPendingLogicCallsResponse, error)
+	LogicCallConfirms(context.Context, *QueryLogicCallConfirmsRequest) (*QueryLogicCallConfirmsResponse, error)
+}
+
+type QueryCurrentValsetRequest struct{}
+type QueryCurrentValsetResponse struct {
+	Valset Valset
+}
+
+type QueryPendingBatchesRequest struct{}
+type QueryPendingBatchesResponse struct {
+	Batches []OutgoingTxBatch
+}
+
+type QueryBatchConfirmsRequest struct {
+	Nonce         uint64
+	TokenContract string
+}
+type QueryBatchConfirmsResponse struct {
+	Confirms []BatchConfirm
+}
+
+type QueryValsetConfirmsRequest struct {
+	Nonce uint64
+}
+type QueryValsetConfirmsResponse struct {
+	Confirms []ValsetConfirm
+}
+
+type QueryPendingSendToEthRequest struct {
+	SenderAddress string
+}
+type QueryPendingSendToEthResponse struct {
+	TransfersInBatches []OutgoingTransferTx
+	UnbatchedTransfers []OutgoingTransferTx
+}
+
+type QueryDenomToERC20Request struct {
+	Denom string
+}
+type QueryDenomToERC20Response struct {
+	ERC20Address string
+}
+
+type QueryERC20ToDenomRequest struct {
+	ERC20Address string
+}
+type QueryERC20ToDenomResponse struct {
+	Denom string
+}
+
+type QueryPendingLogicCallsRequest struct{}
+type QueryPendingLogicCallsResponse struct {
+	Calls []OutgoingLogicCall
+}
+
+type QueryLogicCallConfirmsRequest struct {
+	InvalidationID    []byte
+	InvalidationNonce uint64
+}
+type QueryLogicCallConfirmsResponse struct {
+	Confirms []LogicCallConfirm
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BridgeValidator is a single entry in a Valset: a validator's Ethereum
+// address and the bridge-normalized voting power it held when the Valset
+// was formed
+type BridgeValidator struct {
+	Power      uint64 `json:"power"`
+	EthAddress string `json:"ethereum_address"`
+}
+
+// Valset is a snapshot of the bridge validator multisig set, identified by
+// the nonce it was requested at
+type Valset struct {
+	Nonce   uint64            `json:"nonce"`
+	Members []BridgeValidator `json:"members"`
+	Height  uint64            `json:"height"`
+}
+
+// ValsetConfirm is a validator's Ethereum signature over a Valset's
+// checkpoint, keyed by (nonce, orchestrator) in the store
+type ValsetConfirm struct {
+	Nonce        uint64         `json:"nonce"`
+	Orchestrator sdk.AccAddress `json:"orchestrator"`
+	EthAddress   string         `json:"eth_address"`
+	Signature    string         `json:"signature"`
+}